@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+const burndownChartHeight = 10
+
+var (
+	burndownIteration string
+	burndownFormat    string
+	burndownUnit      string
+	burndownBurnup    bool
+)
+
+// burndownCmd charts the progress of an iteration
+var burndownCmd = &cobra.Command{
+	Use:   "burndown",
+	Short: "Chart the remaining work of an iteration",
+	Long: `Draw a burndown chart of an iteration: the estimate (or number of issues) still
+open at the end of each day, against the ideal line.
+
+An item counts as finished from the moment it was closed or moved into the
+"done" status, whichever came first, using the issue close dates and the
+project status-change events.
+
+Use --burnup to chart the completed work against the scope instead, and
+--format csv or json for the daily values. The scope of each day counts the
+items added to the project by its end. GitHub does not record when an existing
+item's iteration changes, so items moved into the iteration from the backlog
+count from its first day, and items moved out of it are not shown.`,
+	Example: `  # The current iteration
+  gh pm burndown
+
+  # A past iteration, counted in issues
+  gh pm burndown --iteration "Sprint 11" --unit issues
+
+  # Daily values for a spreadsheet
+  gh pm burndown --format csv`,
+	Args: cobra.NoArgs,
+	RunE: runBurndown,
+}
+
+func init() {
+	rootCmd.AddCommand(burndownCmd)
+	burndownCmd.Flags().StringVar(&burndownIteration, "iteration", "@current", "Iteration title, or @current, @next or @previous")
+	burndownCmd.Flags().StringVar(&burndownFormat, "format", "chart", "Output format: chart, csv or json")
+	burndownCmd.Flags().StringVar(&burndownUnit, "unit", "", "Measure work in estimate or issues (default: estimate when items have one)")
+	burndownCmd.Flags().BoolVar(&burndownBurnup, "burnup", false, "Chart completed work against scope instead")
+	addIterationFlags(burndownCmd)
+}
+
+// burndownItem is the weight of an item, when it joined the project and when
+// it was finished
+type burndownItem struct {
+	Weight     float64
+	AddedAt    *time.Time // nil when unknown; the item counts from the first day
+	FinishedAt *time.Time
+}
+
+// burndownPoint is the state at the end of one day; actual values are nil
+// for days that have not begun
+type burndownPoint struct {
+	Date      string   `json:"date"`
+	Scope     *float64 `json:"scope,omitempty"`
+	Remaining *float64 `json:"remaining,omitempty"`
+	Completed *float64 `json:"completed,omitempty"`
+	Ideal     float64  `json:"ideal"`
+}
+
+// burndownReport is the daily progress of an iteration
+type burndownReport struct {
+	Iteration string  `json:"iteration"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Unit      string  `json:"unit"`  // estimate or issues
+	Scope     float64 `json:"scope"` // The items in the iteration now
+
+	Points []burndownPoint `json:"points"`
+}
+
+func runBurndown(cmd *cobra.Command, args []string) error {
+	format := burndownFormat
+	if !cmd.Flags().Changed("format") && (outputFormat == "json" || outputFormat == "csv") {
+		format = outputFormat
+	}
+	if format != "chart" && format != "csv" && format != "json" {
+		return fmt.Errorf("invalid format: %s (expected chart, csv or json)", format)
+	}
+	if burndownUnit != "" && burndownUnit != "estimate" && burndownUnit != "issues" {
+		return fmt.Errorf("invalid unit: %s (expected estimate or issues)", burndownUnit)
+	}
+
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+	iteration, err := command.field.Resolve(burndownIteration, command.now)
+	if err != nil {
+		return err
+	}
+
+	items := command.itemsIn(*iteration)
+	unit := burndownUnit
+	if unit == "" {
+		unit = "issues"
+		for _, item := range items {
+			if itemEstimate(command.config, item) != nil {
+				unit = "estimate"
+				break
+			}
+		}
+	}
+
+	histories := fetchHistories(command.issueAPI, items, func(filter.ProjectIssue) bool { return true })
+	var weighted []burndownItem
+	for i, item := range items {
+		weight := 1.0
+		if unit == "estimate" {
+			weight = 0
+			if estimate := itemEstimate(command.config, item); estimate != nil {
+				weight = *estimate
+			}
+		}
+		weighted = append(weighted, burndownItem{
+			Weight:     weight,
+			AddedAt:    addedToProject(histories[i], command.projectID),
+			FinishedAt: itemFinishedAt(command.config, item, histories[i], command.projectID),
+		})
+	}
+
+	report := computeBurndown(*iteration, weighted, command.now)
+	report.Unit = unit
+
+	switch format {
+	case "json":
+		return output.NewFormatter(output.FormatJSON).Format(report)
+	case "csv":
+		return writeBurndownCSV(report)
+	default:
+		for _, line := range renderBurndownChart(report, burndownBurnup) {
+			fmt.Println(line)
+		}
+		return nil
+	}
+}
+
+// itemFinishedAt returns when a finished item was closed or entered the done
+// status, whichever was first, or nil for unfinished items
+func itemFinishedAt(cfg *config.Config, item filter.ProjectIssue, history []issue.HistoryEvent, projectID string) *time.Time {
+	if !isItemFinished(cfg, item) {
+		return nil
+	}
+
+	var finished *time.Time
+	if closedAt, err := time.Parse(time.RFC3339, item.ClosedAt); err == nil && strings.EqualFold(item.State, "closed") {
+		finished = &closedAt
+	}
+
+	status, _ := item.Fields[statusFieldName(cfg)].(string)
+	if strings.EqualFold(status, statusOptionName(cfg, "done", "Done")) {
+		if doneAt := lastStatusChange(history, projectID, status); doneAt != nil && (finished == nil || doneAt.Before(*finished)) {
+			finished = doneAt
+		}
+	}
+	return finished
+}
+
+// addedToProject returns when the item was last added to the project
+func addedToProject(history []issue.HistoryEvent, projectID string) *time.Time {
+	var added *time.Time
+	for _, event := range history {
+		if event.Type == issue.HistoryAddedToProject && (event.ProjectID == "" || event.ProjectID == projectID) {
+			at := event.CreatedAt
+			added = &at
+		}
+	}
+	return added
+}
+
+// lastStatusChange returns when the item last entered the status in the project
+func lastStatusChange(history []issue.HistoryEvent, projectID, status string) *time.Time {
+	var last *time.Time
+	for _, event := range history {
+		if event.Type != issue.HistoryStatusChanged || (event.ProjectID != "" && event.ProjectID != projectID) {
+			continue
+		}
+		if strings.EqualFold(event.To, status) {
+			at := event.CreatedAt
+			last = &at
+		}
+	}
+	return last
+}
+
+// computeBurndown computes the scope, remaining and completed work at the end
+// of each day of the iteration, and the ideal line from the scope to zero
+func computeBurndown(iteration project.Iteration, items []burndownItem, now time.Time) burndownReport {
+	report := burndownReport{
+		Iteration: iteration.Title,
+		StartDate: iteration.StartDate,
+		EndDate:   iteration.End().AddDate(0, 0, -1).Format("2006-01-02"),
+		Points:    []burndownPoint{},
+	}
+	for _, item := range items {
+		report.Scope += item.Weight
+	}
+
+	start := iteration.Start()
+	for day := 0; day < iteration.Duration; day++ {
+		dayStart := start.AddDate(0, 0, day)
+		dayEnd := dayStart.AddDate(0, 0, 1)
+		point := burndownPoint{
+			Date:  dayStart.Format("2006-01-02"),
+			Ideal: math.Round(report.Scope*(1-float64(day+1)/float64(iteration.Duration))*10) / 10,
+		}
+
+		// Today is shown as it is now; days that have not begun have no values
+		if dayStart.Before(now) {
+			scope, completed := 0.0, 0.0
+			for _, item := range items {
+				if item.AddedAt != nil && !item.AddedAt.Before(dayEnd) {
+					continue
+				}
+				scope += item.Weight
+				if item.FinishedAt != nil && item.FinishedAt.Before(dayEnd) {
+					completed += item.Weight
+				}
+			}
+			remaining := scope - completed
+			point.Scope = &scope
+			point.Completed = &completed
+			point.Remaining = &remaining
+		}
+		report.Points = append(report.Points, point)
+	}
+	return report
+}
+
+// renderBurndownChart draws the remaining (or, for a burnup, completed) work
+// per day as "●" against the ideal line as "·"; a burnup also draws the scope
+// of each day as "─"
+func renderBurndownChart(report burndownReport, burnup bool) []string {
+	kind := "burndown"
+	if burnup {
+		kind = "burnup"
+	}
+	lines := []string{fmt.Sprintf("%s %s (%s)  %s → %s", report.Iteration, kind, report.Unit, report.StartDate, report.EndDate)}
+	if len(report.Points) == 0 {
+		return append(lines, "The iteration has no days")
+	}
+
+	top := report.Scope
+	if top == 0 {
+		top = 1
+	}
+	row := func(value float64) int {
+		return int(math.Round(value / top * burndownChartHeight))
+	}
+
+	// One column of three characters per day
+	grid := make([][]rune, burndownChartHeight+1)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", len(report.Points)*3))
+	}
+	for day, point := range report.Points {
+		col := day*3 + 1
+		guide := point.Ideal
+		actual := point.Remaining
+		if burnup {
+			guide = report.Scope - point.Ideal
+			actual = point.Completed
+		}
+		if burnup && point.Scope != nil {
+			grid[row(*point.Scope)][col] = '─'
+		}
+		grid[row(guide)][col] = '·'
+		if actual != nil {
+			grid[row(*actual)][col] = '●'
+		}
+	}
+
+	labelWidth := len(formatNumber(report.Scope))
+	for r := burndownChartHeight; r >= 0; r-- {
+		label := strings.Repeat(" ", labelWidth)
+		axis := "│"
+		switch r {
+		case burndownChartHeight:
+			label = fmt.Sprintf("%*s", labelWidth, formatNumber(report.Scope))
+			axis = "┤"
+		case burndownChartHeight / 2:
+			label = fmt.Sprintf("%*s", labelWidth, formatNumber(math.Round(report.Scope/2*10)/10))
+			axis = "┤"
+		case 0:
+			label = fmt.Sprintf("%*s", labelWidth, "0")
+			axis = "┼"
+		}
+		lines = append(lines, strings.TrimRight(label+" "+axis+string(grid[r]), " "))
+	}
+
+	var days strings.Builder
+	for _, point := range report.Points {
+		days.WriteString(" " + point.Date[8:10])
+	}
+	lines = append(lines, strings.Repeat(" ", labelWidth+1)+" "+strings.TrimPrefix(days.String(), " "))
+
+	// Summarize the latest day with values
+	for i := len(report.Points) - 1; i >= 0; i-- {
+		point := report.Points[i]
+		if point.Remaining != nil {
+			scope := report.Scope
+			if point.Scope != nil {
+				scope = *point.Scope
+			}
+			lines = append(lines, "", fmt.Sprintf("%s: %s of %s done, %s remaining (ideal %s)",
+				point.Date, formatNumber(*point.Completed), formatNumber(scope),
+				formatNumber(*point.Remaining), formatNumber(point.Ideal)))
+			break
+		}
+	}
+	return lines
+}
+
+// writeBurndownCSV writes one row per day
+func writeBurndownCSV(report burndownReport) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"Date", "Scope", "Remaining", "Completed", "Ideal"})
+
+	value := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return formatNumber(*v)
+	}
+	for _, point := range report.Points {
+		_ = w.Write([]string{point.Date, value(point.Scope), value(point.Remaining), value(point.Completed), formatNumber(point.Ideal)})
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func TestComputeBurndown(t *testing.T) {
+	iteration := project.Iteration{Title: "Sprint 3", StartDate: "2026-03-02", Duration: 4}
+	at := func(day, hour int) *time.Time {
+		value := iteration.Start().AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour)
+		return &value
+	}
+
+	items := []burndownItem{
+		{Weight: 3, FinishedAt: at(0, 15)},
+		{Weight: 2, FinishedAt: at(2, 9)},
+		{Weight: 5},
+		{Weight: 2, FinishedAt: at(-3, 0)}, // Finished before the iteration started
+		{Weight: 4, AddedAt: at(1, 10)},    // Added to the project on the second day
+		{Weight: 1, AddedAt: at(-10, 0)},
+	}
+	report := computeBurndown(iteration, items, *at(2, 12))
+
+	assert.Equal(t, 17.0, report.Scope)
+	assert.Equal(t, "2026-03-05", report.EndDate)
+	require.Len(t, report.Points, 4)
+
+	var scope, remaining []float64
+	for _, point := range report.Points[:3] {
+		require.NotNil(t, point.Remaining)
+		scope = append(scope, *point.Scope)
+		remaining = append(remaining, *point.Remaining)
+	}
+	assert.Equal(t, []float64{13, 17, 17}, scope, "scope grows on the day an item is added")
+	assert.Equal(t, []float64{8, 12, 10}, remaining)
+	assert.Equal(t, 7.0, *report.Points[2].Completed)
+	assert.Nil(t, report.Points[3].Remaining, "days that have not begun have no values")
+	assert.Nil(t, report.Points[3].Scope)
+
+	var ideal []float64
+	for _, point := range report.Points {
+		ideal = append(ideal, point.Ideal)
+	}
+	assert.Equal(t, []float64{12.8, 8.5, 4.3, 0}, ideal)
+}
+
+func TestRenderBurndownChart(t *testing.T) {
+	remaining := func(v float64) *float64 { return &v }
+	report := burndownReport{
+		Iteration: "Sprint 3", StartDate: "2026-03-02", EndDate: "2026-03-03", Unit: "issues", Scope: 4,
+		Points: []burndownPoint{
+			{Date: "2026-03-02", Remaining: remaining(4), Completed: remaining(0), Ideal: 2},
+			{Date: "2026-03-03", Ideal: 0},
+		},
+	}
+
+	lines := renderBurndownChart(report, false)
+	require.Len(t, lines, burndownChartHeight+5)
+	assert.Equal(t, "Sprint 3 burndown (issues)  2026-03-02 → 2026-03-03", lines[0])
+	assert.Equal(t, "4 ┤ ●", lines[1])
+	assert.Equal(t, "2 ┤ ·", lines[6])
+	assert.Equal(t, "0 ┼    ·", lines[11])
+	assert.Equal(t, "   02 03", lines[12])
+	assert.Equal(t, "2026-03-02: 0 of 4 done, 4 remaining (ideal 2)", lines[14])
+
+	burnup := renderBurndownChart(report, true)
+	assert.True(t, strings.HasPrefix(burnup[0], "Sprint 3 burnup"))
+	assert.Equal(t, "0 ┼ ●", burnup[11])
+
+	report.Points[0].Scope = remaining(4)
+	burnup = renderBurndownChart(report, true)
+	assert.Equal(t, "4 ┤ ─  ·", burnup[1], "the scope of each day is drawn")
+}
+
+func TestAddedToProject(t *testing.T) {
+	first := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	history := []issue.HistoryEvent{
+		{Type: issue.HistoryAddedToProject, CreatedAt: first, ProjectID: "P_1"},
+		{Type: issue.HistoryAddedToProject, CreatedAt: first.AddDate(0, 0, 1), ProjectID: "P_2"},
+		{Type: issue.HistoryAddedToProject, CreatedAt: first.AddDate(0, 0, 2), ProjectID: "P_1"},
+	}
+
+	added := addedToProject(history, "P_1")
+	require.NotNil(t, added)
+	assert.Equal(t, first.AddDate(0, 0, 2), *added, "re-adding counts from the last time")
+	assert.Nil(t, addedToProject(nil, "P_1"))
+}
+
+func TestLastStatusChange(t *testing.T) {
+	first := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	history := []issue.HistoryEvent{
+		statusEvent(first, "P_1", "Todo", "Done"),
+		statusEvent(first.AddDate(0, 0, 1), "P_1", "Done", "Todo"),
+		statusEvent(first.AddDate(0, 0, 2), "P_1", "Todo", "done"),
+		statusEvent(first.AddDate(0, 0, 3), "P_2", "Todo", "Done"),
+	}
+
+	last := lastStatusChange(history, "P_1", "Done")
+	require.NotNil(t, last)
+	assert.Equal(t, first.AddDate(0, 0, 2), *last)
+	assert.Nil(t, lastStatusChange(history, "P_1", "In Progress"))
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+// conflictResolver applies an --on-conflict policy to fields that changed
+// between the time they were read and the time they are about to be updated
+type conflictResolver struct {
+	policy issue.ConflictPolicy
+	reader *bufio.Reader
+	out    io.Writer
+}
+
+// resolve reports whether the update should proceed and returns the
+// conflicts annotated with the resolution that was taken
+func (r *conflictResolver) resolve(number int, title string, conflicts []issue.FieldConflict) ([]issue.FieldConflict, bool) {
+	if len(conflicts) == 0 {
+		return nil, true
+	}
+
+	fmt.Fprintf(r.out, "⚠ Issue #%d was changed by someone else since it was read:\n", number)
+	for _, c := range conflicts {
+		fmt.Fprintf(r.out, "  • %s: expected '%s', now '%s'\n", c.Field, displayValue(c.Expected), displayValue(c.Actual))
+	}
+
+	proceed := false
+	switch r.policy {
+	case issue.ConflictForce:
+		proceed = true
+	case issue.ConflictPrompt:
+		fmt.Fprint(r.out, "Overwrite with your changes? [y/N]: ")
+		if r.reader != nil {
+			input, err := r.reader.ReadString('\n')
+			if err == nil {
+				answer := strings.ToLower(strings.TrimSpace(input))
+				proceed = answer == "y" || answer == "yes"
+			}
+		}
+	}
+
+	resolution := issue.ResolutionSkipped
+	if proceed {
+		resolution = issue.ResolutionForced
+		fmt.Fprintf(r.out, "  → overwriting\n")
+	} else {
+		fmt.Fprintf(r.out, "  → skipped field updates for issue #%d (use --on-conflict=force to override)\n", number)
+	}
+
+	resolved := make([]issue.FieldConflict, len(conflicts))
+	for i, c := range conflicts {
+		c.Number = number
+		c.Title = title
+		c.Resolution = resolution
+		resolved[i] = c
+	}
+	return resolved, proceed
+}
+
+// displayValue renders an empty field value as a dash
+func displayValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
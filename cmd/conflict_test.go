@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func TestConflictResolver(t *testing.T) {
+	conflicts := []issue.FieldConflict{{Field: "Status", Expected: "Backlog", Actual: "Ready"}}
+
+	tests := []struct {
+		name               string
+		policy             issue.ConflictPolicy
+		input              string
+		expectedProceed    bool
+		expectedResolution string
+	}{
+		{name: "skip", policy: issue.ConflictSkip, expectedProceed: false, expectedResolution: issue.ResolutionSkipped},
+		{name: "force", policy: issue.ConflictForce, expectedProceed: true, expectedResolution: issue.ResolutionForced},
+		{name: "prompt yes", policy: issue.ConflictPrompt, input: "y\n", expectedProceed: true, expectedResolution: issue.ResolutionForced},
+		{name: "prompt default no", policy: issue.ConflictPrompt, input: "\n", expectedProceed: false, expectedResolution: issue.ResolutionSkipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			resolver := &conflictResolver{
+				policy: tt.policy,
+				reader: bufio.NewReader(strings.NewReader(tt.input)),
+				out:    &out,
+			}
+
+			resolved, proceed := resolver.resolve(7, "Title", conflicts)
+			assert.Equal(t, tt.expectedProceed, proceed)
+			assert.Len(t, resolved, 1)
+			assert.Equal(t, 7, resolved[0].Number)
+			assert.Equal(t, tt.expectedResolution, resolved[0].Resolution)
+			assert.Contains(t, out.String(), "expected 'Backlog', now 'Ready'")
+		})
+	}
+
+	t.Run("no conflicts", func(t *testing.T) {
+		resolver := &conflictResolver{policy: issue.ConflictSkip, out: &bytes.Buffer{}}
+		resolved, proceed := resolver.resolve(1, "Title", nil)
+		assert.True(t, proceed)
+		assert.Nil(t, resolved)
+	})
+}
+
+func TestPendingFieldNames(t *testing.T) {
+	command := &TriageCommand{config: &config.Config{}}
+	fields := []project.Field{
+		{Name: "Status"},
+		{Name: "Priority"},
+		{Name: "Estimate"},
+		{Name: "Size"},
+	}
+	status := "ready"
+	update := IssueUpdate{
+		Issue:        filter.GitHubIssue{Number: 1},
+		StatusChoice: &status,
+		FieldChoices: map[string]string{"size": "M", "unknown": "x"},
+	}
+	triageConfig := config.TriageConfig{
+		Apply: config.TriageApply{Fields: map[string]string{"priority": "p1"}},
+	}
+
+	assert.Equal(t, []string{"Priority", "Size", "Status"}, command.pendingFieldNames(update, triageConfig, fields))
+}
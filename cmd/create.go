@@ -540,6 +540,20 @@ func (c *CreateCommand) updateProjectField(projectID, itemID, fieldName, value s
 		return c.issueAPI.UpdateProjectItemField(projectID, itemID, targetField.ID, optionID)
 	}
 
+	// Text, number and date fields take the value as-is
+	switch targetField.DataType {
+	case "TEXT":
+		return c.issueAPI.UpdateProjectItemFieldValue(projectID, itemID, targetField.ID, map[string]interface{}{"text": value})
+	case "NUMBER":
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric value '%s' for field '%s'", value, fieldName)
+		}
+		return c.issueAPI.UpdateProjectItemFieldValue(projectID, itemID, targetField.ID, map[string]interface{}{"number": num})
+	case "DATE":
+		return c.issueAPI.UpdateProjectItemFieldValue(projectID, itemID, targetField.ID, map[string]interface{}{"date": value})
+	}
+
 	// For other field types, we'd need different handling
 	return fmt.Errorf("unsupported field type '%s' for field '%s'", targetField.DataType, fieldName)
 }
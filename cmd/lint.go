@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var lintLimit int
+
+// lintCmd checks every project item against the requirements of its status
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Report items that do not meet the requirements of their status",
+	Long: `Check every project item against the requirements of its status, set under
+workflow.requirements in .gh-pm.yml, and report the items that fall short.
+
+A requirement can ask for project fields to be set (by field name, or by key
+such as estimate), for an assignee, and for the issue to be closed:
+
+  workflow:
+    requirements:
+      ready:
+        fields: [priority, estimate]
+      in_progress:
+        assignee: true
+      done:
+        closed: true
+
+The command exits with a non-zero status when any item fails, so it can run
+in CI. 'gh pm move' refuses to move an issue into a status whose requirements
+it does not meet.`,
+	Example: `  # Report the items that are not ready for their status
+  gh pm lint
+
+  # As JSON, for scripts
+  gh pm lint --output json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runLint,
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+	lintCmd.Flags().IntVarP(&lintLimit, "limit", "L", 500, "Maximum number of project items to read")
+}
+
+// lintViolation is an item that does not meet the requirements of its status
+type lintViolation struct {
+	Number   int      `json:"number"`
+	Title    string   `json:"title"`
+	URL      string   `json:"url"`
+	Status   string   `json:"status"`
+	Problems []string `json:"problems"`
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+	if cfg.Workflow == nil || len(cfg.Workflow.Requirements) == 0 {
+		return fmt.Errorf("no status requirements configured. Add workflow.requirements to .gh-pm.yml")
+	}
+
+	projectClient, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, projectClient)
+	if err != nil {
+		return err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, lintLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	violations := lintItems(cfg, items)
+	if outputFormat == "json" {
+		if err := output.NewFormatter(output.FormatJSON).Format(violations); err != nil {
+			return err
+		}
+	} else if err := printLintViolations(violations, len(items)); err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		if len(violations) == 1 {
+			return fmt.Errorf("1 item does not meet the requirements of its status")
+		}
+		return fmt.Errorf("%d items do not meet the requirements of their status", len(violations))
+	}
+	return nil
+}
+
+// lintItems checks each item against the requirements of its status
+func lintItems(cfg *config.Config, items []filter.ProjectIssue) []lintViolation {
+	violations := []lintViolation{}
+	statusField := statusFieldName(cfg)
+	for _, item := range items {
+		status, _ := item.Fields[statusField].(string)
+		requirement, ok := cfg.Workflow.Requirements[cfg.StatusKey(status)]
+		if status == "" || !ok {
+			continue
+		}
+		problems := requirementProblems(cfg, requirement, item)
+		if len(problems) == 0 {
+			continue
+		}
+		violations = append(violations, lintViolation{
+			Number:   item.Number,
+			Title:    item.Title,
+			URL:      item.URL,
+			Status:   status,
+			Problems: problems,
+		})
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Number < violations[j].Number
+	})
+	return violations
+}
+
+func printLintViolations(violations []lintViolation, checked int) error {
+	if len(violations) == 0 {
+		fmt.Printf("✓ All %d items meet the requirements of their status\n", checked)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NUMBER\tTITLE\tSTATUS\tREQUIRES")
+	for _, violation := range violations {
+		fmt.Fprintf(w, "#%d\t%s\t%s\t%s\n", violation.Number, truncate(violation.Title, 50),
+			violation.Status, strings.Join(violation.Problems, ", "))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+func TestLintItems(t *testing.T) {
+	cfg := requirementTestConfig()
+	items := []filter.ProjectIssue{
+		{Number: 5, Title: "Done but open", State: "open", Fields: map[string]interface{}{"Status": "Done"}},
+		{Number: 1, Title: "Ready", State: "open", Fields: map[string]interface{}{"Status": "Ready", "Priority": "High", "Points": 2.0}},
+		{Number: 2, Title: "Unestimated", State: "open", Fields: map[string]interface{}{"Status": "ready", "Priority": "High"}},
+		{Number: 3, Title: "Backlog", State: "open", Fields: map[string]interface{}{"Status": "Backlog"}},
+		{Number: 4, Title: "No status", State: "open", Fields: map[string]interface{}{}},
+		{Number: 6, Title: "Unowned", State: "open", Assignees: nil, Fields: map[string]interface{}{"Status": "In Progress"}},
+	}
+
+	violations := lintItems(cfg, items)
+
+	assert.Equal(t, []lintViolation{
+		{Number: 2, Title: "Unestimated", Status: "ready", Problems: []string{"Points"}},
+		{Number: 5, Title: "Done but open", Status: "Done", Problems: []string{"the issue to be closed"}},
+		{Number: 6, Title: "Unowned", Status: "In Progress", Problems: []string{"an assignee"}},
+	}, violations)
+}
+
+func TestLintItemsAllMet(t *testing.T) {
+	items := []filter.ProjectIssue{
+		{Number: 1, State: "closed", Fields: map[string]interface{}{"Status": "Done"}},
+	}
+	assert.Equal(t, []lintViolation{}, lintItems(requirementTestConfig(), items))
+}
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
 	"github.com/yahsan2/gh-pm/pkg/issue"
 	"github.com/yahsan2/gh-pm/pkg/output"
 	"github.com/yahsan2/gh-pm/pkg/project"
@@ -22,7 +26,12 @@ This command allows you to quickly update project fields for an issue:
 - Change priority (e.g., "low", "medium", "high", "critical")
 - Update multiple fields in a single operation
 
-The issue must already be added to the configured project.`,
+The issue must already be added to the configured project.
+
+Status changes are checked against the workflow section of .gh-pm.yml: moves
+that break an allowed transition or a WIP limit, or that enter a status whose
+requirements the issue does not meet, are refused (or only warned about with
+enforce: warn). Use --force to override.`,
 	Example: `  # Change issue status to ready
   gh pm move 15 --status ready
 
@@ -30,17 +39,35 @@ The issue must already be added to the configured project.`,
   gh pm move 123 --priority high
 
   # Update both status and priority
-  gh pm move 42 --status in_progress --priority critical`,
+  gh pm move 42 --status in_progress --priority critical
+
+  # Only move if the status is still the one you last saw
+  gh pm move 42 --status done --expect-status in_review
+
+  # Mark an epic and all of its open sub-issues as done
+  gh pm move 10 --status done --recursive --only-open
+
+  # Preview a recursive move
+  gh pm move 10 --status done --recursive --dry-run
+
+  # Move past a WIP limit or a transition the workflow does not allow
+  gh pm move 42 --status in_progress --force`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMove,
 }
 
 // Command flags
 var (
-	moveStatus   string
-	movePriority string
-	moveRepo     string
-	moveQuiet    bool
+	moveStatus     string
+	movePriority   string
+	moveRepo       string
+	moveQuiet      bool
+	moveOnConflict string
+	moveExpect     string
+	moveRecursive  bool
+	moveOnlyOpen   bool
+	moveDryRun     bool
+	moveForce      bool
 )
 
 func init() {
@@ -55,6 +82,18 @@ func init() {
 
 	// Output control
 	moveCmd.Flags().BoolVarP(&moveQuiet, "quiet", "q", false, "Only output essential information")
+
+	// Concurrency control
+	moveCmd.Flags().StringVar(&moveExpect, "expect-status", "", "Only update if the current status is still this one, e.g. the status you last saw")
+	moveCmd.Flags().StringVar(&moveOnConflict, "on-conflict", "skip", "What to do when the status is not the one given by --expect-status: skip, prompt or force")
+
+	// Sub-issues
+	moveCmd.Flags().BoolVar(&moveRecursive, "recursive", false, "Apply the same change to all sub-issues in the project, recursively")
+	moveCmd.Flags().BoolVar(&moveOnlyOpen, "only-open", false, "With --recursive, skip closed sub-issues")
+	moveCmd.Flags().BoolVar(&moveDryRun, "dry-run", false, "Show what would change without updating anything")
+
+	// Workflow rules
+	moveCmd.Flags().BoolVar(&moveForce, "force", false, "Move even when it breaks the workflow rules in .gh-pm.yml")
 }
 
 type MoveCommand struct {
@@ -62,6 +101,8 @@ type MoveCommand struct {
 	projectClient *project.Client
 	issueClient   *issue.Client
 	formatter     *output.Formatter
+	onConflict    issue.ConflictPolicy
+	guard         *workflowGuard
 }
 
 func runMove(cmd *cobra.Command, args []string) error {
@@ -86,6 +127,14 @@ func runMove(cmd *cobra.Command, args []string) error {
 	if moveStatus == "" && movePriority == "" {
 		return fmt.Errorf("no field updates specified. Use --status or --priority flags")
 	}
+	if moveOnlyOpen && !moveRecursive {
+		return fmt.Errorf("--only-open requires --recursive")
+	}
+
+	onConflict, err := issue.ParseConflictPolicy(moveOnConflict)
+	if err != nil {
+		return err
+	}
 
 	// Create clients
 	projectClient, err := project.NewClient()
@@ -113,6 +162,7 @@ func runMove(cmd *cobra.Command, args []string) error {
 		projectClient: projectClient,
 		issueClient:   issueClient,
 		formatter:     formatter,
+		onConflict:    onConflict,
 	}
 
 	// Execute the move operation
@@ -172,39 +222,245 @@ func (c *MoveCommand) Execute(issueNumber int) error {
 		return fmt.Errorf("failed to get project fields: %w", err)
 	}
 
-	// Track changes made
+	// Check the status change against the workflow rules
+	values := fieldValueNames(fields, projectItem.FieldValues)
+	currentStatus := values[statusFieldName(c.config)]
+	c.guard = newWorkflowGuard(c.config, moveForce, os.Stdout, func() ([]filter.ProjectIssue, error) {
+		searchClient, err := issue.NewSearchClient(c.config)
+		if err != nil {
+			return nil, err
+		}
+		return searchClient.FetchProjectIssues(projectID, 0)
+	})
+	if moveStatus != "" {
+		err := c.guard.check(issueNumber, currentStatus, moveStatus)
+		if err == nil {
+			err = c.guard.checkRequirements(issueNumber, moveStatus, c.requirementView(currentIssue.State, currentIssue.Assignees, values))
+		}
+		if err != nil {
+			if !moveDryRun {
+				return err
+			}
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}
+
+	if moveDryRun {
+		fmt.Printf("🔍 DRY-RUN: would update issue #%d: %s\n", issueNumber, currentIssue.Title)
+		for _, update := range c.describeUpdates(currentStatus) {
+			fmt.Printf("  • %s\n", update)
+		}
+	} else {
+		// Someone may have moved the issue since the user last saw its status
+		if moveExpect != "" {
+			resolver := &conflictResolver{policy: c.onConflict, reader: bufio.NewReader(os.Stdin), out: os.Stdout}
+			conflicts := expectedStatusConflicts(c.config, moveExpect, currentStatus)
+			if _, proceed := resolver.resolve(issueNumber, currentIssue.Title, conflicts); !proceed {
+				return fmt.Errorf("issue #%d is no longer in status '%s'; no changes applied", issueNumber, moveExpect)
+			}
+		}
+
+		updatesApplied, err := c.applyUpdates(projectID, projectItem.ID, fields)
+		if err != nil {
+			return err
+		}
+		if moveStatus != "" {
+			c.guard.record(currentStatus, moveStatus)
+		}
+
+		// Prepare success output
+		if !moveQuiet {
+			fmt.Printf("✓ Updated issue #%d: %s\n", issueNumber, currentIssue.Title)
+			for _, update := range updatesApplied {
+				fmt.Printf("  • %s\n", update)
+			}
+			fmt.Printf("🔗 %s\n", currentIssue.URL)
+		} else {
+			fmt.Printf("Updated issue #%d\n", issueNumber)
+		}
+	}
+
+	if moveRecursive {
+		return c.moveDescendants(projectID, currentIssue, fields)
+	}
+	return nil
+}
+
+// applyUpdates sets the requested status and priority on a project item
+func (c *MoveCommand) applyUpdates(projectID, itemID string, fields []project.Field) ([]string, error) {
 	var updatesApplied []string
 
 	// Update Status field if specified
 	if moveStatus != "" {
-		if err := c.updateProjectField(projectID, projectItem.ID, "Status", moveStatus, fields); err != nil {
-			return fmt.Errorf("failed to update status: %w", err)
+		if err := c.updateProjectField(projectID, itemID, statusFieldName(c.config), moveStatus, fields); err != nil {
+			return updatesApplied, fmt.Errorf("failed to update status: %w", err)
 		}
 		updatesApplied = append(updatesApplied, fmt.Sprintf("Status → %s", moveStatus))
 	}
 
 	// Update Priority field if specified
 	if movePriority != "" {
-		if err := c.updateProjectField(projectID, projectItem.ID, "Priority", movePriority, fields); err != nil {
-			return fmt.Errorf("failed to update priority: %w", err)
+		if err := c.updateProjectField(projectID, itemID, priorityFieldName(c.config), movePriority, fields); err != nil {
+			return updatesApplied, fmt.Errorf("failed to update priority: %w", err)
 		}
 		updatesApplied = append(updatesApplied, fmt.Sprintf("Priority → %s", movePriority))
 	}
 
-	// Prepare success output
-	if !moveQuiet {
-		fmt.Printf("✓ Updated issue #%d: %s\n", issueNumber, currentIssue.Title)
-		for _, update := range updatesApplied {
-			fmt.Printf("  • %s\n", update)
+	return updatesApplied, nil
+}
+
+// describeUpdates lists the requested changes, showing the current status when known
+func (c *MoveCommand) describeUpdates(currentStatus string) []string {
+	var updates []string
+	if moveStatus != "" {
+		if currentStatus != "" {
+			updates = append(updates, fmt.Sprintf("Status: %s → %s", currentStatus, moveStatus))
+		} else {
+			updates = append(updates, fmt.Sprintf("Status → %s", moveStatus))
 		}
-		fmt.Printf("🔗 %s\n", currentIssue.URL)
+	}
+	if movePriority != "" {
+		updates = append(updates, fmt.Sprintf("Priority → %s", movePriority))
+	}
+	return updates
+}
+
+// requirementView is the item as it will be after the move, for checking the
+// requirements of its new status
+func (c *MoveCommand) requirementView(state string, assignees []string, values map[string]string) filter.ProjectIssue {
+	item := filter.ProjectIssue{State: state, Assignees: assignees, Fields: map[string]interface{}{}}
+	for name, value := range values {
+		item.Fields[name] = value
+	}
+	if movePriority != "" {
+		item.Fields[priorityFieldName(c.config)] = movePriority
+	}
+	return item
+}
+
+// moveDescendants applies the same change to every sub-issue below the issue
+// that is in the project
+func (c *MoveCommand) moveDescendants(projectID string, parent *issue.Issue, fields []project.Field) error {
+	tree, err := c.issueClient.GetIssueTree(parent.ID, issue.TreeOptions{
+		StatusField: statusFieldName(c.config),
+		ProjectID:   projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	targets := cascadeTargets(tree, moveOnlyOpen)
+	if len(targets) == 0 {
+		fmt.Println("No sub-issues to update")
+		return nil
+	}
+
+	if moveDryRun {
+		fmt.Printf("Would also update %d sub-issues:\n", len(targets))
 	} else {
-		fmt.Printf("Updated issue #%d\n", issueNumber)
+		fmt.Printf("Updating %d sub-issues:\n", len(targets))
+	}
+
+	moved, notInProject, failed := 0, 0, 0
+	for _, target := range targets {
+		itemID, _, err := c.issueClient.GetProjectItemID(target.ID, projectID)
+		if err != nil {
+			fmt.Printf("  ✗ #%d %s: %v\n", target.Number, target.Title, err)
+			failed++
+			continue
+		}
+		if itemID == "" {
+			fmt.Printf("  - #%d %s (not in project, skipped)\n", target.Number, target.Title)
+			notInProject++
+			continue
+		}
+
+		if moveStatus != "" {
+			err := c.guard.check(target.Number, target.Status, moveStatus)
+			if err == nil && c.guard.hasRequirements(moveStatus) {
+				var values map[string]string
+				if itemData, readErr := c.projectClient.GetProjectItemForIssue(projectID, target.ID); readErr == nil {
+					values = fieldValueNames(fields, itemData.FieldValues)
+				}
+				err = c.guard.checkRequirements(target.Number, moveStatus, c.requirementView(target.State, target.Assignees, values))
+			}
+			if err != nil && moveDryRun {
+				fmt.Printf("  ⚠ %v\n", err)
+			} else if err != nil {
+				fmt.Printf("  ✗ #%d %s: %v\n", target.Number, target.Title, err)
+				failed++
+				continue
+			}
+		}
+
+		if moveDryRun {
+			fmt.Printf("  • #%d %s: %s\n", target.Number, target.Title, strings.Join(c.describeUpdates(target.Status), ", "))
+			moved++
+			continue
+		}
+
+		if _, err := c.applyUpdates(projectID, itemID, fields); err != nil {
+			fmt.Printf("  ✗ #%d %s: %v\n", target.Number, target.Title, err)
+			failed++
+			continue
+		}
+		if moveStatus != "" {
+			c.guard.record(target.Status, moveStatus)
+		}
+		if !moveQuiet {
+			fmt.Printf("  ✓ #%d %s\n", target.Number, target.Title)
+		}
+		moved++
+	}
+
+	verb := "Updated"
+	if moveDryRun {
+		verb = "Would update"
 	}
+	fmt.Printf("%s %d sub-issues", verb, moved)
+	if notInProject > 0 {
+		fmt.Printf(", %d not in project", notInProject)
+	}
+	if failed > 0 {
+		fmt.Printf(", %d failed", failed)
+	}
+	fmt.Println()
 
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d sub-issues", failed, len(targets))
+	}
 	return nil
 }
 
+// cascadeTargets returns all descendants of the tree's root, depth-first,
+// leaving out closed issues when onlyOpen is set
+func cascadeTargets(tree *issue.IssueTree, onlyOpen bool) []*issue.IssueTree {
+	var targets []*issue.IssueTree
+	for _, child := range tree.Children {
+		if !onlyOpen || !child.IsClosed() {
+			targets = append(targets, child)
+		}
+		targets = append(targets, cascadeTargets(child, onlyOpen)...)
+	}
+	return targets
+}
+
+// expectedStatusConflicts reports the current status as a conflict when it is
+// not the expected one, given as a key or an option name
+func expectedStatusConflicts(cfg *config.Config, expected, current string) []issue.FieldConflict {
+	if strings.EqualFold(expected, current) {
+		return nil
+	}
+	if key := cfg.StatusKey(expected); key != "" && key == cfg.StatusKey(current) {
+		return nil
+	}
+	return []issue.FieldConflict{{
+		Field:    statusFieldName(cfg),
+		Expected: statusOptionName(cfg, expected, expected),
+		Actual:   current,
+	}}
+}
+
 func (c *MoveCommand) selectRepository() string {
 	// Use command-line flag if provided
 	if moveRepo != "" {
@@ -238,7 +494,7 @@ func (c *MoveCommand) updateProjectField(projectID, itemID, fieldName, value str
 	if targetField.DataType == "SINGLE_SELECT" {
 		var optionID string
 		// Look for matching option based on config mapping
-		if fieldName == "Status" {
+		if fieldName == statusFieldName(c.config) {
 			if statusField, ok := c.config.Fields["status"]; ok {
 				// Use the configured mapping
 				if mappedValue, ok := statusField.Values[value]; ok {
@@ -251,7 +507,7 @@ func (c *MoveCommand) updateProjectField(projectID, itemID, fieldName, value str
 					}
 				}
 			}
-		} else if fieldName == "Priority" {
+		} else if fieldName == priorityFieldName(c.config) {
 			if priorityField, ok := c.config.Fields["priority"]; ok {
 				// Use the configured mapping
 				if mappedValue, ok := priorityField.Values[value]; ok {
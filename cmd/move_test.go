@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func TestCascadeTargets(t *testing.T) {
+	tree := &issue.IssueTree{Number: 1, Children: []*issue.IssueTree{
+		{Number: 2, State: "OPEN", Children: []*issue.IssueTree{
+			{Number: 4, State: "CLOSED"},
+			{Number: 5, State: "OPEN"},
+		}},
+		{Number: 3, State: "CLOSED", Children: []*issue.IssueTree{
+			{Number: 6, State: "OPEN"},
+		}},
+	}}
+
+	numbers := func(nodes []*issue.IssueTree) []int {
+		result := []int{}
+		for _, node := range nodes {
+			result = append(result, node.Number)
+		}
+		return result
+	}
+
+	assert.Equal(t, []int{2, 4, 5, 3, 6}, numbers(cascadeTargets(tree, false)))
+	assert.Equal(t, []int{2, 5, 6}, numbers(cascadeTargets(tree, true)), "open issues below closed ones are still included")
+	assert.Empty(t, cascadeTargets(&issue.IssueTree{Number: 1}, false))
+}
+
+func TestMoveDescribeUpdates(t *testing.T) {
+	oldStatus, oldPriority := moveStatus, movePriority
+	defer func() { moveStatus, movePriority = oldStatus, oldPriority }()
+
+	c := &MoveCommand{}
+
+	moveStatus, movePriority = "done", ""
+	assert.Equal(t, []string{"Status: Todo → done"}, c.describeUpdates("Todo"))
+	assert.Equal(t, []string{"Status → done"}, c.describeUpdates(""))
+
+	moveStatus, movePriority = "", "high"
+	assert.Equal(t, []string{"Priority → high"}, c.describeUpdates("Todo"))
+}
+
+func TestExpectedStatusConflicts(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"in_review": "In Review", "done": "Done"}},
+	}}
+
+	tests := []struct {
+		name     string
+		expected string
+		current  string
+		want     []issue.FieldConflict
+	}{
+		{name: "same option name", expected: "In Review", current: "In Review"},
+		{name: "different case", expected: "in review", current: "In Review"},
+		{name: "key", expected: "in_review", current: "In Review"},
+		{
+			name: "moved by someone else", expected: "in_review", current: "Done",
+			want: []issue.FieldConflict{{Field: "Status", Expected: "In Review", Actual: "Done"}},
+		},
+		{
+			name: "no status", expected: "in_review", current: "",
+			want: []issue.FieldConflict{{Field: "Status", Expected: "In Review", Actual: ""}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, expectedStatusConflicts(cfg, tt.expected, tt.current))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+var (
+	reparentRepo string
+	reparentTo   int
+)
+
+// reparentCmd moves a sub-issue under a different parent
+var reparentCmd = &cobra.Command{
+	Use:   "reparent [child]",
+	Short: "Move a sub-issue under a different parent issue",
+	Long: `Move an issue under a different parent issue: it is removed from its current
+parent, if any, and added as the last sub-issue of the new parent.
+
+The "## Sub-Issues" checklists of both parents, where present, are kept in sync.`,
+	Example: `  # Move #125 under epic #200
+  gh pm reparent 125 --to 200
+
+  # In another repository
+  gh pm reparent 125 --to 200 --repo owner/repo`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("requires exactly 1 argument")
+		}
+		if _, err := strconv.Atoi(args[0]); err != nil {
+			return fmt.Errorf("invalid issue number: %s", args[0])
+		}
+		return nil
+	},
+	RunE: runReparent,
+}
+
+func init() {
+	rootCmd.AddCommand(reparentCmd)
+	reparentCmd.Flags().StringVarP(&reparentRepo, "repo", "r", "", "Repository (owner/repo format)")
+	reparentCmd.Flags().IntVar(&reparentTo, "to", 0, "New parent issue number")
+	_ = reparentCmd.MarkFlagRequired("to")
+}
+
+func runReparent(cmd *cobra.Command, args []string) error {
+	childNum, _ := strconv.Atoi(args[0])
+	if reparentTo == childNum {
+		return fmt.Errorf("an issue cannot be its own parent")
+	}
+
+	client := issue.NewClient()
+	child, err := client.GetIssueWithRepo(childNum, reparentRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	newParent, err := client.GetIssueWithRepo(reparentTo, reparentRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get new parent issue: %w", err)
+	}
+
+	oldParent, err := client.GetParentIssue(child.ID)
+	if err != nil {
+		return err
+	}
+	if oldParent != nil && oldParent.ID == newParent.ID {
+		fmt.Printf("#%d is already a sub-issue of #%d\n", childNum, reparentTo)
+		return nil
+	}
+
+	if oldParent != nil {
+		if err := client.RemoveSubIssue(oldParent.ID, child.ID); err != nil {
+			return fmt.Errorf("failed to remove #%d from #%d: %w", childNum, oldParent.Number, err)
+		}
+	}
+
+	if err := client.AddSubIssue(newParent.ID, child.ID); err != nil {
+		// Put the issue back so it is not left without a parent
+		if oldParent != nil {
+			if restoreErr := client.AddSubIssue(oldParent.ID, child.ID); restoreErr != nil {
+				return fmt.Errorf("failed to add #%d to #%d (%v) and to restore it under #%d: %w", childNum, reparentTo, err, oldParent.Number, restoreErr)
+			}
+		}
+		return fmt.Errorf("failed to add #%d to #%d: %w", childNum, reparentTo, err)
+	}
+
+	if oldParent != nil {
+		fmt.Printf("✓ Moved #%d from #%d to #%d\n", childNum, oldParent.Number, reparentTo)
+	} else {
+		fmt.Printf("✓ Added #%d as a sub-issue of #%d\n", childNum, reparentTo)
+	}
+
+	// Keep the checklists of both parents in sync
+	var parents []issue.Issue
+	if oldParent != nil {
+		repo := repoFromIssueURL(oldParent.URL)
+		if previous, err := client.GetIssueWithRepo(oldParent.Number, repo); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to get #%d: %v\n", oldParent.Number, err)
+		} else {
+			parents = append(parents, previous)
+		}
+	}
+	parents = append(parents, newParent)
+
+	for _, parent := range parents {
+		repo := repoFromIssueURL(parent.URL)
+		if repo == "" {
+			repo = reparentRepo
+		}
+		if updated, err := refreshSubIssuesSection(client, parent, repo); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to sync the sub-issue checklist of #%d: %v\n", parent.Number, err)
+		} else if updated {
+			fmt.Printf("✓ Synced sub-issue checklist of #%d\n", parent.Number)
+		}
+	}
+	return nil
+}
+
+// repoFromIssueURL returns "owner/repo" from an issue URL such as
+// https://github.com/owner/repo/issues/12, or "" when it cannot be parsed
+func repoFromIssueURL(url string) string {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	if len(parts) < 5 || parts[len(parts)-2] != "issues" {
+		return ""
+	}
+	return parts[len(parts)-4] + "/" + parts[len(parts)-3]
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func TestRepoFromIssueURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"https://github.com/owner/repo/issues/12", "owner/repo"},
+		{"https://github.example.com/org/service/issues/7/", "org/service"},
+		{"https://github.com/owner/repo/pull/3", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.expected, repoFromIssueURL(tt.url))
+		})
+	}
+}
+
+func TestFindSubIssueByNumber(t *testing.T) {
+	subIssues := []issue.SubIssue{{ID: "I_1", Number: 1}, {ID: "I_2", Number: 2}}
+
+	assert.Equal(t, "I_2", findSubIssueByNumber(subIssues, 2).ID)
+	assert.Nil(t, findSubIssueByNumber(subIssues, 3))
+}
@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var (
+	rollupRepo      string
+	rollupDryRun    bool
+	rollupRecursive bool
+)
+
+// rollupCmd writes sub-issue progress into the parent's project fields
+var rollupCmd = &cobra.Command{
+	Use:   "rollup [parent...]",
+	Short: "Roll up sub-issue progress into the parent's project fields",
+	Long: `Walk the sub-issues of each parent, sum the configured source field (e.g.
+Estimate) over open and closed sub-issues, compute the percent complete and
+write the results into the parent's project item.
+
+Configure the fields in .gh-pm.yml:
+
+  rollup:
+    source: Estimate              # NUMBER field summed over sub-issues
+    progress: Progress            # set to the percent complete
+    remaining: Remaining estimate # set to the sum over open sub-issues
+    total: Total estimate         # set to the sum over all sub-issues
+    recursive: false              # use all leaf descendants instead of direct sub-issues
+
+Progress is weighted by the source field when sub-issues have values, and by
+the number of closed sub-issues otherwise.`,
+	Example: `  # Update the rollup fields of issues 10 and 12
+  gh pm rollup 10 12
+
+  # Preview the values without writing them
+  gh pm rollup 10 --dry-run
+
+  # Include nested sub-issues
+  gh pm rollup 10 --recursive`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("requires at least 1 parent issue number")
+		}
+		for _, arg := range args {
+			if _, err := strconv.Atoi(arg); err != nil {
+				return fmt.Errorf("invalid issue number: %s", arg)
+			}
+		}
+		return nil
+	},
+	RunE: runRollup,
+}
+
+func init() {
+	rootCmd.AddCommand(rollupCmd)
+	rollupCmd.Flags().StringVarP(&rollupRepo, "repo", "r", "", "Repository (owner/repo format)")
+	rollupCmd.Flags().BoolVar(&rollupDryRun, "dry-run", false, "Show the rolled up values without updating the project")
+	rollupCmd.Flags().BoolVar(&rollupRecursive, "recursive", false, "Use all leaf descendants instead of direct sub-issues")
+}
+
+// RollupCommand computes and writes rollups for parent issues
+type RollupCommand struct {
+	config    *config.Config
+	rollup    config.RollupConfig
+	client    *project.Client
+	issueAPI  *issue.Client
+	projectID string
+	fields    []project.Field
+}
+
+// rollupResult is the rolled up progress of one parent issue
+type rollupResult struct {
+	Number    int      `json:"number"`
+	Title     string   `json:"title"`
+	Children  int      `json:"children"`
+	Closed    int      `json:"closed"`
+	Estimated int      `json:"estimated"` // Sub-issues with a source value
+	Total     float64  `json:"total"`
+	Done      float64  `json:"done"`
+	Remaining float64  `json:"remaining"`
+	Percent   float64  `json:"percent"`
+	Updated   []string `json:"updated,omitempty"`
+	Skipped   bool     `json:"skipped,omitempty"` // No sub-issues, so nothing was written
+}
+
+func runRollup(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	client, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+
+	command, err := newRollupCommand(cfg, client, issue.NewClient())
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("recursive") {
+		command.rollup.Recursive = rollupRecursive
+	}
+
+	var results []rollupResult
+	var failed []string
+	for _, arg := range args {
+		number, _ := strconv.Atoi(arg)
+		parent, err := command.issueAPI.GetIssueWithRepo(number, rollupRepo)
+		if err != nil {
+			fmt.Printf("Warning: failed to get issue #%d: %v\n", number, err)
+			failed = append(failed, arg)
+			continue
+		}
+
+		result, err := command.Rollup(parent.ID, rollupDryRun)
+		if err != nil {
+			fmt.Printf("Warning: failed to roll up #%d: %v\n", number, err)
+			failed = append(failed, arg)
+			continue
+		}
+		results = append(results, result)
+
+		if result.Skipped {
+			fmt.Fprintf(os.Stderr, "Warning: issue #%d has no sub-issues; its fields were left unchanged\n", number)
+		} else if outputFormat != "json" {
+			fmt.Println(command.describe(result, rollupDryRun))
+		}
+	}
+
+	if outputFormat == "json" {
+		if err := output.NewFormatter(output.FormatJSON).Format(results); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("rollup failed for %d of %d issues", len(failed), len(args))
+	}
+	return nil
+}
+
+// newRollupCommand resolves the project and its fields for the configured rollup
+func newRollupCommand(cfg *config.Config, client *project.Client, issueAPI *issue.Client) (*RollupCommand, error) {
+	if cfg.Rollup == nil {
+		return nil, fmt.Errorf("no rollup configured; add a 'rollup' section to %s", config.ConfigFileName)
+	}
+	if err := cfg.Rollup.Validate(); err != nil {
+		return nil, err
+	}
+
+	projectID := cfg.GetProjectID()
+	if projectID == "" {
+		var proj *project.Project
+		var err error
+		if cfg.Project.Org != "" {
+			proj, err = client.GetProject(cfg.Project.Org, cfg.Project.Name, cfg.Project.Number)
+		} else {
+			proj, err = client.GetCurrentUserProject(cfg.Project.Name, cfg.Project.Number)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		projectID = proj.ID
+		cfg.SetProjectID(projectID)
+	}
+
+	fields, err := loadProjectFields(cfg, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RollupCommand{
+		config:    cfg,
+		rollup:    *cfg.Rollup,
+		client:    client,
+		issueAPI:  issueAPI,
+		projectID: projectID,
+		fields:    fields,
+	}, nil
+}
+
+// Rollup computes the rollup for the parent and, unless dryRun, writes it to
+// the parent's project item
+func (c *RollupCommand) Rollup(parentID string, dryRun bool) (rollupResult, error) {
+	maxDepth := 1
+	if c.rollup.Recursive {
+		maxDepth = 0
+	}
+	tree, err := c.issueAPI.GetIssueTree(parentID, issue.TreeOptions{
+		StatusField: statusFieldName(c.config),
+		NumberField: c.rollup.Source,
+		ProjectID:   c.projectID,
+		MaxDepth:    maxDepth,
+	})
+	if err != nil {
+		return rollupResult{}, err
+	}
+
+	result := computeRollup(tree, c.rollup.Recursive)
+	if dryRun || result.Skipped {
+		return result, nil
+	}
+
+	itemID, _, err := c.issueAPI.GetProjectItemID(parentID, c.projectID)
+	if err != nil {
+		return result, err
+	}
+	if itemID == "" {
+		return result, fmt.Errorf("issue #%d is not in the project", tree.Number)
+	}
+
+	var failures []string
+	for _, target := range rollupTargets(c.rollup, result) {
+		field := findFieldByName(c.fields, target.field)
+		if field == nil {
+			failures = append(failures, fmt.Sprintf("field '%s' not found in project", target.field))
+			continue
+		}
+		if field.DataType != "NUMBER" {
+			failures = append(failures, fmt.Sprintf("field '%s' is not a number field", field.Name))
+			continue
+		}
+		value := map[string]interface{}{"number": target.value}
+		if err := c.issueAPI.UpdateProjectItemFieldValue(c.projectID, itemID, field.ID, value); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", field.Name, err))
+			continue
+		}
+		result.Updated = append(result.Updated, field.Name)
+	}
+
+	if len(failures) > 0 {
+		return result, fmt.Errorf("failed to update fields: %s", strings.Join(failures, "; "))
+	}
+	return result, nil
+}
+
+// rollupTarget is a value to write into a parent field
+type rollupTarget struct {
+	field string
+	value float64
+}
+
+// rollupTargets lists the configured fields with their new values
+func rollupTargets(rollup config.RollupConfig, result rollupResult) []rollupTarget {
+	var targets []rollupTarget
+	if rollup.Progress != "" {
+		targets = append(targets, rollupTarget{rollup.Progress, result.Percent})
+	}
+	if rollup.Remaining != "" {
+		targets = append(targets, rollupTarget{rollup.Remaining, result.Remaining})
+	}
+	if rollup.Total != "" {
+		targets = append(targets, rollupTarget{rollup.Total, result.Total})
+	}
+	return targets
+}
+
+// computeRollup sums the source values over the direct sub-issues, or over
+// all leaf descendants when recursive
+func computeRollup(tree *issue.IssueTree, recursive bool) rollupResult {
+	result := rollupResult{Number: tree.Number, Title: tree.Title}
+
+	items := tree.Children
+	if recursive {
+		items = treeLeaves(tree.Children)
+	}
+
+	for _, item := range items {
+		result.Children++
+		closed := item.IsClosed()
+		if closed {
+			result.Closed++
+		}
+		if item.Value != nil {
+			result.Estimated++
+			result.Total += *item.Value
+			if closed {
+				result.Done += *item.Value
+			}
+		}
+	}
+	result.Remaining = result.Total - result.Done
+	// An issue without sub-issues has nothing to roll up; writing zeros
+	// would overwrite its own values
+	result.Skipped = result.Children == 0
+
+	switch {
+	case result.Total > 0:
+		result.Percent = math.Round(result.Done / result.Total * 100)
+	case result.Children > 0:
+		result.Percent = math.Round(float64(result.Closed) / float64(result.Children) * 100)
+	}
+	return result
+}
+
+// treeLeaves returns the issues below nodes that have no sub-issues themselves
+func treeLeaves(nodes []*issue.IssueTree) []*issue.IssueTree {
+	var leaves []*issue.IssueTree
+	for _, node := range nodes {
+		if len(node.Children) == 0 {
+			leaves = append(leaves, node)
+			continue
+		}
+		leaves = append(leaves, treeLeaves(node.Children)...)
+	}
+	return leaves
+}
+
+// describe summarizes a rollup result on one line
+func (c *RollupCommand) describe(result rollupResult, dryRun bool) string {
+	line := fmt.Sprintf("#%d %s: %d/%d sub-issues closed", result.Number, result.Title, result.Closed, result.Children)
+	if c.rollup.Source != "" && result.Estimated > 0 {
+		line += fmt.Sprintf(", %s %s of %s done", c.rollup.Source, formatNumber(result.Done), formatNumber(result.Total))
+	}
+
+	var values []string
+	for _, target := range rollupTargets(c.rollup, result) {
+		values = append(values, fmt.Sprintf("%s %s", target.field, formatNumber(target.value)))
+	}
+	arrow := "→"
+	if dryRun {
+		arrow = "→ would set"
+	}
+	return fmt.Sprintf("%s %s %s", line, arrow, strings.Join(values, ", "))
+}
+
+// formatNumber prints whole numbers without decimals
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func estimate(value float64) *float64 {
+	return &value
+}
+
+func sampleRollupTree() *issue.IssueTree {
+	return &issue.IssueTree{
+		Number: 10, Title: "Epic",
+		Children: []*issue.IssueTree{
+			{Number: 11, State: "CLOSED", Value: estimate(3)},
+			{Number: 12, State: "OPEN", Value: estimate(5)},
+			{Number: 13, State: "OPEN", Value: estimate(2), Children: []*issue.IssueTree{
+				{Number: 14, State: "CLOSED", Value: estimate(1)},
+				{Number: 15, State: "OPEN"},
+			}},
+		},
+	}
+}
+
+func TestComputeRollup(t *testing.T) {
+	tests := []struct {
+		name      string
+		tree      *issue.IssueTree
+		recursive bool
+		expected  rollupResult
+	}{
+		{
+			name: "direct sub-issues weighted by estimate",
+			tree: sampleRollupTree(),
+			expected: rollupResult{
+				Number: 10, Title: "Epic", Children: 3, Closed: 1, Estimated: 3,
+				Total: 10, Done: 3, Remaining: 7, Percent: 30,
+			},
+		},
+		{
+			name:      "leaf descendants",
+			tree:      sampleRollupTree(),
+			recursive: true,
+			expected: rollupResult{
+				Number: 10, Title: "Epic", Children: 4, Closed: 2, Estimated: 3,
+				Total: 9, Done: 4, Remaining: 5, Percent: 44,
+			},
+		},
+		{
+			name: "no estimates falls back to counts",
+			tree: &issue.IssueTree{Number: 1, Children: []*issue.IssueTree{
+				{State: "CLOSED"}, {State: "CLOSED"}, {State: "OPEN"},
+			}},
+			expected: rollupResult{Number: 1, Children: 3, Closed: 2, Percent: 67},
+		},
+		{
+			name:     "no sub-issues is skipped",
+			tree:     &issue.IssueTree{Number: 1, State: "CLOSED"},
+			expected: rollupResult{Number: 1, Skipped: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, computeRollup(tt.tree, tt.recursive))
+		})
+	}
+}
+
+func TestRollupTargetsAndDescribe(t *testing.T) {
+	c := &RollupCommand{rollup: config.RollupConfig{Source: "Estimate", Progress: "Progress", Remaining: "Remaining estimate"}}
+	result := computeRollup(sampleRollupTree(), false)
+
+	assert.Equal(t, []rollupTarget{{"Progress", 30}, {"Remaining estimate", 7}}, rollupTargets(c.rollup, result))
+	assert.Equal(t, "#10 Epic: 1/3 sub-issues closed, Estimate 3 of 10 done → Progress 30, Remaining estimate 7", c.describe(result, false))
+	assert.Equal(t, "#10 Epic: 1/3 sub-issues closed, Estimate 3 of 10 done → would set Progress 30, Remaining estimate 7", c.describe(result, true))
+}
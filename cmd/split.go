@@ -2,26 +2,28 @@ package cmd
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
-	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/yahsan2/gh-pm/pkg/config"
 	"github.com/yahsan2/gh-pm/pkg/issue"
 	"github.com/yahsan2/gh-pm/pkg/output"
 )
 
 var (
-	splitFrom   string
-	splitRepo   string
-	splitDryRun bool
+	splitFrom     string
+	splitRepo     string
+	splitDryRun   bool
+	splitDepth    int
+	splitHeadings bool
+	splitCopy     []string
+	splitApply    []string
 )
 
 // splitCmd represents the split command
@@ -40,11 +42,26 @@ Examples:
   # Split from stdin
   cat tasks.md | gh pm split 123
 
+  # Split from a task spec with per-task body, labels, assignee, estimate and fields
+  gh pm split 123 --from=./tasks.yml
+
+  # Inline syntax in checklists: @assignee, ~estimate and #label
+  gh pm split 123 "Write migration @alice ~3 #backend" "Update docs #docs"
+
   # Split from JSON array
   gh pm split 123 '["Task 1", "Task 2", "Task 3"]'
 
   # Split from command arguments
-  gh pm split 123 "Task 1" "Task 2" "Task 3"`,
+  gh pm split 123 "Task 1" "Task 2" "Task 3"
+
+  # Create two levels of sub-issues from nested checklists
+  gh pm split 123 --from=body --depth 2
+
+  # Use "##" headings as intermediate parents and preview the tree
+  gh pm split 123 --from=./plan.md --depth 3 --headings --dry-run
+
+  # Copy only Iteration from the parent and start every sub-issue in Todo
+  gh pm split 123 --from=body --copy-fields Iteration --apply status:todo`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 {
 			return fmt.Errorf("requires at least 1 argument")
@@ -60,129 +77,85 @@ Examples:
 
 func init() {
 	rootCmd.AddCommand(splitCmd)
-	splitCmd.Flags().StringVar(&splitFrom, "from", "", "Source of tasks: 'body' (issue body) or file path")
+	splitCmd.Flags().StringVar(&splitFrom, "from", "", "Source of tasks: 'body' (issue body) or file path (.yml/.yaml/.json task specs are supported)")
 	splitCmd.Flags().StringVar(&splitRepo, "repo", "", "Repository (owner/repo format)")
 	splitCmd.Flags().BoolVar(&splitDryRun, "dry-run", false, "Preview what would be created without making changes")
-}
-
-// isGhSubIssueInstalled checks if gh sub-issue extension is installed
-func isGhSubIssueInstalled() bool {
-	cmd := exec.Command("gh", "extension", "list")
-	output, err := cmd.Output()
-	if err != nil {
-		return false
-	}
-	return strings.Contains(string(output), "gh-sub-issue")
-}
-
-// SubIssueInfo represents a sub-issue from gh sub-issue list
-type SubIssueInfo struct {
-	Number int
-	State  string
-	Title  string
+	splitCmd.Flags().IntVar(&splitDepth, "depth", 1, "Levels of sub-issues to create from nested checklists")
+	splitCmd.Flags().BoolVar(&splitHeadings, "headings", false, "Use markdown headings as intermediate parent issues (requires --depth 2 or more)")
+	splitCmd.Flags().StringSliceVar(&splitCopy, "copy-fields", []string{"Status", "Priority", "Iteration"}, "Project fields to copy from the parent's project item")
+	splitCmd.Flags().StringSliceVar(&splitApply, "apply", []string{}, "Project field values to set on sub-issues, overriding copied values (e.g., 'status:todo')")
 }
 
 // getExistingSubIssues gets the list of existing sub-issues for a parent issue
-func getExistingSubIssues(parentIssueNum int, repo string) ([]SubIssueInfo, error) {
-	args := []string{"sub-issue", "list", strconv.Itoa(parentIssueNum)}
-	if repo != "" {
-		args = append(args, "--repo", repo)
-	}
-
-	cmd := exec.Command("gh", args...)
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, err
-	}
-
-	var subIssues []SubIssueInfo
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-
-		// Parse format: "123	open	Title of issue"
-		parts := strings.Split(line, "\t")
-		if len(parts) >= 3 {
-			number, _ := strconv.Atoi(parts[0])
-			subIssues = append(subIssues, SubIssueInfo{
-				Number: number,
-				State:  parts[1],
-				Title:  parts[2],
-			})
-		}
-	}
-
-	return subIssues, nil
+func getExistingSubIssues(client *issue.Client, parentIssue issue.Issue) ([]issue.SubIssue, error) {
+	return client.GetSubIssues(parentIssue.ID)
 }
 
-// isTaskAlreadySubIssue checks if a task already exists as a sub-issue
-func isTaskAlreadySubIssue(task string, existingSubIssues []SubIssueInfo) bool {
+// findExistingSubIssue returns the sub-issue that matches a task, if any
+func findExistingSubIssue(task string, existingSubIssues []issue.SubIssue) *issue.SubIssue {
 	taskLower := strings.ToLower(strings.TrimSpace(task))
-	for _, subIssue := range existingSubIssues {
+	for i, subIssue := range existingSubIssues {
 		titleLower := strings.ToLower(strings.TrimSpace(subIssue.Title))
 		// Check for exact match or if the existing title contains the task
 		if titleLower == taskLower || strings.Contains(titleLower, taskLower) {
-			return true
+			return &existingSubIssues[i]
 		}
 		// Also check if task contains the existing title (in case of slight variations)
 		if strings.Contains(taskLower, titleLower) {
-			return true
+			return &existingSubIssues[i]
 		}
 	}
-	return false
+	return nil
 }
 
 func runSplit(cmd *cobra.Command, args []string) error {
-	// Check if gh sub-issue extension is installed (skip for dry-run)
-	if !splitDryRun && !isGhSubIssueInstalled() {
-		fmt.Println("❌ gh sub-issue extension is not installed.")
-		fmt.Println("\nThis command requires the gh sub-issue extension to create properly linked sub-issues.")
-		fmt.Println("Please install it by running:")
-		fmt.Println("\n  gh extension install yahsan2/gh-sub-issue")
-		fmt.Println("\nFor more information, visit: https://github.com/yahsan2/gh-sub-issue")
-		return fmt.Errorf("gh sub-issue extension is required")
-	}
-
 	// Parse issue number
 	issueNum, err := strconv.Atoi(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid issue number: %s", args[0])
 	}
 
+	if splitDepth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	if splitHeadings && splitDepth < 2 {
+		return fmt.Errorf("--headings requires --depth 2 or more")
+	}
+	opts := taskTreeOptions{Depth: splitDepth, Headings: splitHeadings}
+
 	// Get tasks based on input method
-	var tasks []string
+	var tasks []*splitTask
 
 	if splitFrom == "body" {
 		// Extract from issue body
-		tasks, err = extractTasksFromIssueBody(issueNum, splitRepo)
+		tasks, err = extractTasksFromIssueBody(issueNum, splitRepo, opts)
 		if err != nil {
 			return fmt.Errorf("failed to extract tasks from issue body: %w", err)
 		}
 	} else if splitFrom != "" {
 		// Read from file
-		tasks, err = extractTasksFromFile(splitFrom)
+		tasks, err = extractTasksFromFile(splitFrom, opts)
 		if err != nil {
 			return fmt.Errorf("failed to read tasks from file: %w", err)
 		}
 	} else if len(args) > 1 {
 		// Check if first argument after issue number is JSON
 		if strings.HasPrefix(args[1], "[") {
-			err = json.Unmarshal([]byte(args[1]), &tasks)
+			var titles []string
+			err = json.Unmarshal([]byte(args[1]), &titles)
 			if err != nil {
 				return fmt.Errorf("failed to parse JSON tasks: %w", err)
 			}
+			tasks = taskLeaves(titles)
 		} else {
 			// Use remaining arguments as tasks
-			tasks = args[1:]
+			tasks = taskLeaves(args[1:])
 		}
 	} else {
 		// Check if stdin has data
 		stat, _ := os.Stdin.Stat()
 		if (stat.Mode() & os.ModeCharDevice) == 0 {
-			tasks, err = extractTasksFromReader(os.Stdin)
+			tasks, err = extractTasksFromReader(os.Stdin, opts)
 			if err != nil {
 				return fmt.Errorf("failed to read tasks from stdin: %w", err)
 			}
@@ -208,10 +181,21 @@ func runSplit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get parent issue: %w", err)
 	}
 
+	// Sub-issues join the configured project, if any
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		// Configuration is optional for split command
+		cfg = &config.Config{}
+	}
+	proj, err := newSplitProject(cfg, client, parentIssue, splitCopy, splitApply)
+	if err != nil {
+		return err
+	}
+
 	// Get existing sub-issues to avoid duplicates
-	var existingSubIssues []SubIssueInfo
+	var existingSubIssues []issue.SubIssue
 	if !splitDryRun {
-		existingSubIssues, err = getExistingSubIssues(issueNum, splitRepo)
+		existingSubIssues, err = getExistingSubIssues(client, parentIssue)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to get existing sub-issues: %v\n", err)
 			// Continue anyway, but might create duplicates
@@ -249,33 +233,29 @@ func runSplit(cmd *cobra.Command, args []string) error {
 		fmt.Println("\nSub-issues that would be created:")
 		fmt.Println("─────────────────────────────────")
 
-		for _, task := range tasks {
-			wouldCreateCount++
-			fmt.Printf("  %d. %s\n", wouldCreateCount, task)
-
-			// Show what would be inherited
-			inheritedItems := []string{}
-			if len(parentIssue.Labels) > 0 {
-				labelCount := 0
-				for _, label := range parentIssue.Labels {
-					if label.Name != "epic" && label.Name != "parent" && label.Name != "sub-task" {
-						labelCount++
-					}
-				}
-				if labelCount > 0 {
-					inheritedItems = append(inheritedItems, fmt.Sprintf("%d labels", labelCount))
-				}
-			}
-			if len(parentIssue.Assignees) > 0 {
-				inheritedItems = append(inheritedItems, fmt.Sprintf("%d assignees", len(parentIssue.Assignees)))
-			}
-			if parentIssue.Milestone != "" {
-				inheritedItems = append(inheritedItems, "milestone")
-			}
+		wouldCreateCount = len(taskTitles(tasks))
+		fmt.Printf("#%d %s\n", parentIssue.Number, parentIssue.Title)
+		for _, line := range renderTaskTree(tasks, "") {
+			fmt.Println(line)
+		}
 
-			if len(inheritedItems) > 0 {
-				fmt.Printf("     → Inherits: %s\n", strings.Join(inheritedItems, ", "))
-			}
+		// Show what would be inherited
+		inheritedItems := []string{}
+		if labelCount := len(inheritedLabels(parentIssue)); labelCount > 0 {
+			inheritedItems = append(inheritedItems, fmt.Sprintf("%d labels", labelCount))
+		}
+		if len(parentIssue.Assignees) > 0 {
+			inheritedItems = append(inheritedItems, fmt.Sprintf("%d assignees", len(parentIssue.Assignees)))
+		}
+		if parentIssue.Milestone != "" {
+			inheritedItems = append(inheritedItems, "milestone")
+		}
+
+		if len(inheritedItems) > 0 {
+			fmt.Printf("\n→ Each sub-issue inherits: %s\n", strings.Join(inheritedItems, ", "))
+		}
+		if proj != nil {
+			fmt.Printf("→ Project: %s\n", proj.describe(parentIssue.Number))
 		}
 
 		fmt.Println("\n─────────────────────────────────")
@@ -283,41 +263,21 @@ func runSplit(cmd *cobra.Command, args []string) error {
 		fmt.Println("\nTo actually create these sub-issues, run without --dry-run")
 
 	} else {
-		// Normal mode: actually create sub-issues
-		for i, task := range tasks {
-			// Check if this task already exists as a sub-issue
-			if isTaskAlreadySubIssue(task, existingSubIssues) {
-				fmt.Printf("⏭️  Skipping (already exists): %s\n", task)
-				skippedCount++
-				continue
-			}
-
-			subIssue, err := createSubIssue(client, parentIssue, task, i+1, splitRepo)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to create sub-issue for task '%s': %v\n", task, err)
-				continue
+		// Normal mode: actually create sub-issues, level by level
+		if hasTaskLabels(tasks) {
+			if names, err := client.ListLabelsWithRepo(splitRepo); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to list labels: %v\n", err)
+			} else {
+				dropUnknownLabels(tasks, names, os.Stderr)
 			}
-			createdIssues = append(createdIssues, subIssue)
-			fmt.Printf("✓ Created sub-issue #%d: %s\n", subIssue.Number, subIssue.Title)
 		}
+		createdIssues, skippedCount = createTaskTree(client, proj, parentIssue, tasks, existingSubIssues, splitRepo)
 
 		if skippedCount > 0 {
 			fmt.Printf("\nSkipped %d tasks that already have sub-issues\n", skippedCount)
 		}
 	}
 
-	// Optional: Update parent issue body with sub-issue links
-	// Note: GitHub's native sub-issue feature will show these automatically
-	// Uncomment if you want to also add links in the issue body
-	/*
-		if len(createdIssues) > 0 {
-			err = updateParentIssueWithSubIssues(client, parentIssue, createdIssues, splitRepo)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to update parent issue with sub-issue links: %v\n", err)
-			}
-		}
-	*/
-
 	// Output summary (skip for dry-run in non-JSON format)
 	if splitDryRun && outputFormat != "json" {
 		// Already printed detailed preview above
@@ -346,7 +306,10 @@ func runSplit(cmd *cobra.Command, args []string) error {
 			"dry_run":            true,
 			"parent_issue":       issueNum,
 			"would_create_count": wouldCreateCount,
-			"tasks":              tasks,
+			"tasks":              taskTitles(tasks),
+		}
+		if isNested(tasks) {
+			summary["tree"] = tasks
 		}
 		return formatter.Format(summary)
 	} else {
@@ -360,28 +323,36 @@ func runSplit(cmd *cobra.Command, args []string) error {
 	}
 }
 
-func extractTasksFromIssueBody(issueNum int, repo string) ([]string, error) {
+func extractTasksFromIssueBody(issueNum int, repo string, opts taskTreeOptions) ([]*splitTask, error) {
 	client := issue.NewClient()
 	parentIssue, err := client.GetIssueWithRepo(issueNum, repo)
 	if err != nil {
 		return nil, err
 	}
 
-	tasks := extractChecklistItems(parentIssue.Body)
+	tasks := parseTaskTree(parentIssue.Body, opts)
 	return tasks, nil
 }
 
-func extractTasksFromFile(filepath string) ([]string, error) {
+func extractTasksFromFile(filepath string, opts taskTreeOptions) ([]*splitTask, error) {
+	if format := taskSpecFormat(filepath); format != "" {
+		data, err := os.ReadFile(filepath)
+		if err != nil {
+			return nil, err
+		}
+		return parseTaskSpec(data, format, opts)
+	}
+
 	file, err := os.Open(filepath)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	return extractTasksFromReader(file)
+	return extractTasksFromReader(file, opts)
 }
 
-func extractTasksFromReader(reader io.Reader) ([]string, error) {
+func extractTasksFromReader(reader io.Reader, opts taskTreeOptions) ([]*splitTask, error) {
 	scanner := bufio.NewScanner(reader)
 
 	// Try to detect if it's JSON
@@ -393,132 +364,176 @@ func extractTasksFromReader(reader io.Reader) ([]string, error) {
 
 	content := strings.TrimSpace(allContent.String())
 
-	// Check if it's JSON array
-	if strings.HasPrefix(content, "[") {
-		var jsonTasks []string
-		err := json.Unmarshal([]byte(content), &jsonTasks)
-		if err == nil {
-			return jsonTasks, nil
+	// Check if it's a JSON array of titles or a JSON task spec
+	if strings.HasPrefix(content, "[") || strings.HasPrefix(content, "{") {
+		if tasks, err := parseTaskSpec([]byte(content), "json", opts); err == nil {
+			return tasks, nil
 		}
 	}
 
 	// Otherwise, extract checklist items
-	return extractChecklistItems(content), nil
+	return parseTaskTree(content, opts), nil
 }
 
+// extractChecklistItems returns every checklist item as a flat list of titles
 func extractChecklistItems(text string) []string {
-	tasks := []string{}
-
-	// Match GitHub-style checkboxes: - [ ] or - [x]
-	checkboxPattern := regexp.MustCompile(`^[\s]*[-*]\s*\[[ xX]\]\s*(.+)`)
-
-	lines := strings.Split(text, "\n")
-	for _, line := range lines {
-		matches := checkboxPattern.FindStringSubmatch(line)
-		if len(matches) > 1 {
-			task := strings.TrimSpace(matches[1])
-			if task != "" {
-				tasks = append(tasks, task)
+	return taskTitles(parseTaskTree(text, taskTreeOptions{Depth: 1}))
+}
+
+// createTaskTree creates sub-issues for the tasks under parentIssue and then,
+// recursively, for their sub-tasks. Tasks that already exist as sub-issues are
+// reused as parents for their sub-tasks instead of being created again.
+func createTaskTree(client *issue.Client, proj *splitProject, parentIssue issue.Issue, tasks []*splitTask, existingSubIssues []issue.SubIssue, repo string) ([]issue.Issue, int) {
+	var created []issue.Issue
+	skipped := 0
+
+	for _, task := range tasks {
+		var taskIssue issue.Issue
+		var children []issue.SubIssue
+
+		if existing := findExistingSubIssue(task.Title, existingSubIssues); existing != nil {
+			fmt.Printf("⏭️  Skipping (already exists): %s\n", task.Title)
+			skipped++
+			if len(task.Children) == 0 {
+				continue
+			}
+
+			taskIssue = issue.Issue{
+				ID:        existing.ID,
+				Number:    existing.Number,
+				Title:     existing.Title,
+				URL:       existing.URL,
+				State:     existing.State,
+				Labels:    parentIssue.Labels,
+				Assignees: parentIssue.Assignees,
+				Milestone: parentIssue.Milestone,
 			}
+			var err error
+			children, err = client.GetSubIssues(existing.ID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get existing sub-issues of #%d: %v\n", existing.Number, err)
+			}
+		} else {
+			subIssue, err := createSubIssue(client, parentIssue, task, repo)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to create sub-issue for task '%s': %v\n", task.Title, err)
+				continue
+			}
+			fmt.Printf("✓ Created sub-issue #%d: %s (under #%d)\n", subIssue.Number, subIssue.Title, parentIssue.Number)
+
+			if proj != nil {
+				if err := proj.addSubIssue(subIssue, task); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: sub-issue #%d: %v\n", subIssue.Number, err)
+				}
+			} else if task.hasProjectValues() {
+				fmt.Fprintf(os.Stderr, "Warning: sub-issue #%d: no project configured, ignoring estimate and field values\n", subIssue.Number)
+			}
+			created = append(created, subIssue)
+			taskIssue = subIssue
+		}
+
+		if len(task.Children) > 0 {
+			nested, nestedSkipped := createTaskTree(client, proj, taskIssue, task.Children, children, repo)
+			created = append(created, nested...)
+			skipped += nestedSkipped
 		}
 	}
 
-	return tasks
+	return created, skipped
+}
+
+// hasTaskLabels reports whether any task in the tree has labels of its own
+func hasTaskLabels(tasks []*splitTask) bool {
+	for _, task := range tasks {
+		if len(task.Labels) > 0 || hasTaskLabels(task.Children) {
+			return true
+		}
+	}
+	return false
 }
 
-func createSubIssue(client *issue.Client, parentIssue issue.Issue, task string, index int, repo string) (issue.Issue, error) {
-	// Use gh sub-issue create to create a linked sub-issue
-	args := []string{"sub-issue", "create",
-		"--parent", strconv.Itoa(parentIssue.Number),
-		"--title", task,
-		"--body", fmt.Sprintf("## Task\n%s", task),
+// dropUnknownLabels removes task labels that do not exist in the repository,
+// so that their sub-issues are created without them instead of failing
+func dropUnknownLabels(tasks []*splitTask, existing []string, w io.Writer) {
+	for _, task := range tasks {
+		var kept []string
+		for _, name := range task.Labels {
+			if containsFold(existing, name) {
+				kept = append(kept, name)
+			} else {
+				fmt.Fprintf(w, "Warning: label '%s' does not exist; creating '%s' without it\n", name, task.Title)
+			}
+		}
+		task.Labels = kept
+		dropUnknownLabels(task.Children, existing, w)
 	}
+}
 
-	// Add labels from parent (except certain meta labels)
+// inheritedLabels returns the parent's labels that sub-issues inherit (all but meta labels)
+func inheritedLabels(parentIssue issue.Issue) []issue.Label {
+	var labels []issue.Label
 	for _, label := range parentIssue.Labels {
 		if label.Name != "epic" && label.Name != "parent" && label.Name != "sub-task" {
-			args = append(args, "--label", label.Name)
+			labels = append(labels, label)
 		}
 	}
+	return labels
+}
 
-	// Add assignees from parent
-	for _, assignee := range parentIssue.Assignees {
-		args = append(args, "--assignee", assignee)
+// subIssueLabels returns the inherited labels plus the task's own, without duplicates
+func subIssueLabels(parentIssue issue.Issue, task *splitTask) []issue.Label {
+	labels := inheritedLabels(parentIssue)
+	for _, name := range task.Labels {
+		duplicate := false
+		for _, label := range labels {
+			if strings.EqualFold(label.Name, name) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			labels = append(labels, issue.Label{Name: name})
+		}
 	}
+	return labels
+}
 
-	// Add milestone if present
-	if parentIssue.Milestone != "" {
-		args = append(args, "--milestone", parentIssue.Milestone)
+func createSubIssue(client *issue.Client, parentIssue issue.Issue, task *splitTask, repo string) (issue.Issue, error) {
+	req := issue.IssueRequest{
+		Title:     task.Title,
+		Body:      task.Body,
+		Assignees: parentIssue.Assignees,
+		Milestone: parentIssue.Milestone,
 	}
-
-	// Add repo if specified
-	if repo != "" {
-		args = append(args, "--repo", repo)
+	if req.Body == "" {
+		req.Body = fmt.Sprintf("## Task\n%s", task.Title)
 	}
-
-	cmd := exec.Command("gh", args...)
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		return issue.Issue{}, fmt.Errorf("failed to create sub-issue: %w\nstderr: %s", err, stderr.String())
-	}
-
-	// Parse the output to get issue number and URL
-	output := strings.TrimSpace(stdout.String())
-	if output == "" {
-		return issue.Issue{}, fmt.Errorf("no output from gh sub-issue create")
+	// A task's own assignee replaces the parent's
+	if task.Assignee != "" {
+		req.Assignees = []string{task.Assignee}
 	}
 
-	// Extract issue number from URL (format: https://github.com/owner/repo/issues/123)
-	parts := strings.Split(output, "/")
-	if len(parts) < 2 {
-		return issue.Issue{}, fmt.Errorf("unexpected output format: %s", output)
+	// Add labels from parent (except certain meta labels) and the task's own labels
+	labels := subIssueLabels(parentIssue, task)
+	for _, label := range labels {
+		req.Labels = append(req.Labels, label.Name)
 	}
 
-	numberStr := parts[len(parts)-1]
-	number, err := strconv.Atoi(numberStr)
+	created, err := client.CreateIssueWithRepo(req, repo)
 	if err != nil {
-		return issue.Issue{}, fmt.Errorf("failed to parse issue number from URL: %s", output)
-	}
-
-	return issue.Issue{
-		Number: number,
-		Title:  task,
-		URL:    output,
-	}, nil
-}
-
-func updateParentIssueWithSubIssues(client *issue.Client, parentIssue issue.Issue, subIssues []issue.Issue, repo string) error {
-	// Build sub-issues section
-	subIssuesSection := "\n\n## Sub-Issues\n"
-	for _, subIssue := range subIssues {
-		subIssuesSection += fmt.Sprintf("- [ ] #%d %s\n", subIssue.Number, subIssue.Title)
+		return issue.Issue{}, fmt.Errorf("failed to create sub-issue: %w", err)
 	}
 
-	// Update parent issue body
-	updatedBody := parentIssue.Body
-
-	// Check if sub-issues section already exists
-	if strings.Contains(updatedBody, "## Sub-Issues") {
-		// Replace existing section
-		re := regexp.MustCompile(`(?s)## Sub-Issues.*?(?:##|$)`)
-		updatedBody = re.ReplaceAllString(updatedBody, subIssuesSection)
-	} else {
-		// Append new section
-		updatedBody += subIssuesSection
-	}
-
-	// Keep existing labels
-	labels := []string{}
-	for _, label := range parentIssue.Labels {
-		labels = append(labels, label.Name)
+	// Link the new issue to the parent using GitHub's native hierarchy
+	linked, err := client.AddSubIssueByURL(parentIssue.ID, created.URL)
+	if err != nil {
+		return issue.Issue{}, fmt.Errorf("created issue #%d but failed to link it to #%d: %w", created.Number, parentIssue.Number, err)
 	}
 
-	return client.UpdateIssueWithRepo(parentIssue.Number, issue.IssueRequest{
-		Title:  parentIssue.Title,
-		Body:   updatedBody,
-		Labels: labels,
-	}, repo)
+	created.ID = linked.ID
+	created.State = linked.State
+	created.Labels = labels
+	created.Assignees = req.Assignees
+	created.Milestone = parentIssue.Milestone
+	return created, nil
 }
@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+// splitProject adds new sub-issues to the configured project and sets their
+// field values, copied from the parent's project item or given via --apply
+type splitProject struct {
+	projectID string
+	fields    []project.Field
+	inherited map[string]interface{} // field name -> raw value on the parent's item
+	apply     map[string]string      // field name -> value
+	creator   *CreateCommand
+	issueAPI  *issue.Client
+}
+
+// newSplitProject prepares project membership for sub-issues of parentIssue.
+// It returns nil when no project is configured.
+func newSplitProject(cfg *config.Config, issueAPI *issue.Client, parentIssue issue.Issue, copyFields, applyFlags []string) (*splitProject, error) {
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return nil, nil
+	}
+
+	client, err := project.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project client: %w", err)
+	}
+
+	projectID := cfg.GetProjectID()
+	if projectID == "" {
+		var proj *project.Project
+		if cfg.Project.Org != "" {
+			proj, err = client.GetProject(cfg.Project.Org, cfg.Project.Name, cfg.Project.Number)
+		} else {
+			proj, err = client.GetCurrentUserProject(cfg.Project.Name, cfg.Project.Number)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		projectID = proj.ID
+		cfg.SetProjectID(projectID)
+	}
+
+	fields, err := loadProjectFields(cfg, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	apply, err := parseSplitApply(applyFlags, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &splitProject{
+		projectID: projectID,
+		fields:    fields,
+		inherited: make(map[string]interface{}),
+		apply:     apply,
+		creator:   &CreateCommand{config: cfg, client: client, issueAPI: issueAPI},
+		issueAPI:  issueAPI,
+	}
+
+	// The parent may not be in the project; then there is nothing to copy
+	if len(copyFields) > 0 {
+		if item, err := client.GetProjectItemForIssue(projectID, parentIssue.ID); err == nil {
+			p.inherited = inheritedFieldValues(fields, item.FieldValues, copyFields)
+		}
+	}
+
+	return p, nil
+}
+
+// parseSplitApply parses --apply "field:value" pairs, resolving field names
+// against the project fields
+func parseSplitApply(applyFlags []string, fields []project.Field) (map[string]string, error) {
+	apply := make(map[string]string)
+	for _, flag := range applyFlags {
+		parts := strings.SplitN(flag, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid apply format: %s (expected 'field:value')", flag)
+		}
+		key := strings.TrimSpace(parts[0])
+		field := findFieldByName(fields, key)
+		if field == nil {
+			return nil, fmt.Errorf("field '%s' not found in project", key)
+		}
+		apply[field.Name] = strings.TrimSpace(parts[1])
+	}
+	return apply, nil
+}
+
+// inheritedFieldValues picks the selected fields out of a project item's
+// values (keyed by field ID), returning them keyed by field name
+func inheritedFieldValues(fields []project.Field, values map[string]interface{}, copyFields []string) map[string]interface{} {
+	inherited := make(map[string]interface{})
+	for _, name := range copyFields {
+		field := findFieldByName(fields, strings.TrimSpace(name))
+		if field == nil {
+			continue
+		}
+		if value, ok := values[field.ID]; ok {
+			inherited[field.Name] = value
+		}
+	}
+	return inherited
+}
+
+// fieldValueInput converts a raw project item value into a ProjectV2FieldValue input
+func fieldValueInput(dataType string, value interface{}) (map[string]interface{}, bool) {
+	switch dataType {
+	case "SINGLE_SELECT":
+		return map[string]interface{}{"singleSelectOptionId": value}, true
+	case "ITERATION":
+		return map[string]interface{}{"iterationId": value}, true
+	case "TEXT":
+		return map[string]interface{}{"text": value}, true
+	case "NUMBER":
+		return map[string]interface{}{"number": value}, true
+	case "DATE":
+		return map[string]interface{}{"date": value}, true
+	default:
+		return nil, false
+	}
+}
+
+// describe summarizes which fields sub-issues will get, for the dry-run preview
+func (p *splitProject) describe(parentNumber int) string {
+	var copied []string
+	for name := range p.inherited {
+		if _, overridden := p.apply[name]; !overridden {
+			copied = append(copied, name)
+		}
+	}
+	sort.Strings(copied)
+
+	var applied []string
+	for name, value := range p.apply {
+		applied = append(applied, fmt.Sprintf("%s: %s", name, value))
+	}
+	sort.Strings(applied)
+
+	parts := []string{"added to project"}
+	if len(copied) > 0 {
+		parts = append(parts, fmt.Sprintf("copies %s from #%d", strings.Join(copied, ", "), parentNumber))
+	}
+	if len(applied) > 0 {
+		parts = append(parts, "sets "+strings.Join(applied, ", "))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// addSubIssue adds a sub-issue to the project and sets its field values:
+// copied values first, then --apply, then the task's own estimate and fields
+func (p *splitProject) addSubIssue(subIssue issue.Issue, task *splitTask) error {
+	itemID, _, err := p.issueAPI.AddToProjectWithDatabaseID(subIssue.ID, p.projectID)
+	if err != nil {
+		return fmt.Errorf("failed to add issue to project: %w", err)
+	}
+
+	values, failures := p.taskValues(task)
+
+	for name, value := range p.inherited {
+		if _, overridden := p.apply[name]; overridden {
+			continue
+		}
+		if _, overridden := values[name]; overridden {
+			continue
+		}
+		field := findFieldByName(p.fields, name)
+		input, ok := fieldValueInput(field.DataType, value)
+		if !ok {
+			continue
+		}
+		if err := p.issueAPI.UpdateProjectItemFieldValue(p.projectID, itemID, field.ID, input); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	for name, value := range p.apply {
+		if _, overridden := values[name]; overridden {
+			continue
+		}
+		if err := p.creator.updateProjectField(p.projectID, itemID, name, value, p.fields); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	for name, value := range values {
+		if err := p.creator.updateProjectField(p.projectID, itemID, name, value, p.fields); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to set fields: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// taskValues resolves a task's estimate and field values to project field
+// names, reporting the fields that the project does not have
+func (p *splitProject) taskValues(task *splitTask) (map[string]string, []string) {
+	values := make(map[string]string)
+	var missing []string
+	if task == nil {
+		return values, missing
+	}
+
+	if task.Estimate != "" {
+		if field := findFieldByName(p.fields, "Estimate"); field != nil {
+			values[field.Name] = string(task.Estimate)
+		} else {
+			missing = append(missing, "field 'Estimate' not found in project")
+		}
+	}
+
+	for name, value := range task.Fields {
+		if field := findFieldByName(p.fields, strings.TrimSpace(name)); field != nil {
+			values[field.Name] = value
+		} else {
+			missing = append(missing, fmt.Sprintf("field '%s' not found in project", name))
+		}
+	}
+	sort.Strings(missing)
+
+	return values, missing
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var splitTestFields = []project.Field{
+	{ID: "F_status", Name: "Status", DataType: "SINGLE_SELECT"},
+	{ID: "F_priority", Name: "Priority", DataType: "SINGLE_SELECT"},
+	{ID: "F_iteration", Name: "Iteration", DataType: "ITERATION"},
+	{ID: "F_estimate", Name: "Estimate", DataType: "NUMBER"},
+}
+
+func TestInheritedFieldValues(t *testing.T) {
+	values := map[string]interface{}{
+		"F_status":    "opt_in_progress",
+		"F_iteration": "iter_7",
+		"F_estimate":  3.0,
+	}
+
+	inherited := inheritedFieldValues(splitTestFields, values, []string{"status", "Priority", "Iteration", "Missing"})
+
+	assert.Equal(t, map[string]interface{}{
+		"Status":    "opt_in_progress",
+		"Iteration": "iter_7",
+	}, inherited, "only selected fields with a value on the parent are copied")
+}
+
+func TestFieldValueInput(t *testing.T) {
+	tests := []struct {
+		dataType string
+		value    interface{}
+		expected map[string]interface{}
+		ok       bool
+	}{
+		{"SINGLE_SELECT", "opt_1", map[string]interface{}{"singleSelectOptionId": "opt_1"}, true},
+		{"ITERATION", "iter_1", map[string]interface{}{"iterationId": "iter_1"}, true},
+		{"TEXT", "notes", map[string]interface{}{"text": "notes"}, true},
+		{"NUMBER", 2.5, map[string]interface{}{"number": 2.5}, true},
+		{"DATE", "2024-05-01", map[string]interface{}{"date": "2024-05-01"}, true},
+		{"LABELS", "bug", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			input, ok := fieldValueInput(tt.dataType, tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, input)
+		})
+	}
+}
+
+func TestParseSplitApply(t *testing.T) {
+	apply, err := parseSplitApply([]string{"status:todo", " estimate : 3 "}, splitTestFields)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Status": "todo", "Estimate": "3"}, apply)
+
+	_, err = parseSplitApply([]string{"status"}, splitTestFields)
+	assert.Error(t, err)
+
+	_, err = parseSplitApply([]string{"unknown:value"}, splitTestFields)
+	assert.Error(t, err)
+}
+
+func TestSplitProjectDescribe(t *testing.T) {
+	p := &splitProject{
+		inherited: map[string]interface{}{"Status": "opt_1", "Iteration": "iter_1"},
+		apply:     map[string]string{"Status": "todo"},
+	}
+
+	assert.Equal(t, "added to project, copies Iteration from #12, sets Status: todo", p.describe(12))
+}
+
+func TestSplitProjectTaskValues(t *testing.T) {
+	p := &splitProject{fields: splitTestFields}
+
+	values, missing := p.taskValues(&splitTask{
+		Estimate: "5",
+		Fields:   map[string]string{"priority": "p1", "Team": "core"},
+	})
+	assert.Equal(t, map[string]string{"Estimate": "5", "Priority": "p1"}, values)
+	assert.Equal(t, []string{"field 'Team' not found in project"}, missing)
+
+	values, missing = p.taskValues(&splitTask{Title: "Plain"})
+	assert.Empty(t, values)
+	assert.Empty(t, missing)
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+var (
+	splitReorderRepo   string
+	splitReorderBefore int
+	splitReorderAfter  int
+)
+
+// splitReorderCmd moves a sub-issue within its parent's sub-issue list
+var splitReorderCmd = &cobra.Command{
+	Use:   "reorder [parent] [child]",
+	Short: "Move a sub-issue before or after another sub-issue",
+	Long: `Change the position of a sub-issue in its parent's sub-issue list.
+
+The parent's "## Sub-Issues" checklist, if it has one, is rewritten in the new order.
+
+Examples:
+  # Move #125 before #124 under parent #123
+  gh pm split reorder 123 125 --before 124
+
+  # Move #124 after #126
+  gh pm split reorder 123 124 --after 126`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("requires a parent and a child issue number")
+		}
+		for _, arg := range args {
+			if _, err := strconv.Atoi(arg); err != nil {
+				return fmt.Errorf("invalid issue number: %s", arg)
+			}
+		}
+		return nil
+	},
+	RunE: runSplitReorder,
+}
+
+func init() {
+	splitCmd.AddCommand(splitReorderCmd)
+	splitReorderCmd.Flags().StringVar(&splitReorderRepo, "repo", "", "Repository (owner/repo format)")
+	splitReorderCmd.Flags().IntVar(&splitReorderBefore, "before", 0, "Place the child directly before this sub-issue")
+	splitReorderCmd.Flags().IntVar(&splitReorderAfter, "after", 0, "Place the child directly after this sub-issue")
+}
+
+func runSplitReorder(cmd *cobra.Command, args []string) error {
+	parentNum, _ := strconv.Atoi(args[0])
+	childNum, _ := strconv.Atoi(args[1])
+
+	if (splitReorderBefore == 0) == (splitReorderAfter == 0) {
+		return fmt.Errorf("exactly one of --before or --after is required")
+	}
+	otherNum, position := splitReorderBefore, "before"
+	if splitReorderAfter != 0 {
+		otherNum, position = splitReorderAfter, "after"
+	}
+	if otherNum == childNum {
+		return fmt.Errorf("cannot move #%d %s itself", childNum, position)
+	}
+
+	client := issue.NewClient()
+	parentIssue, err := client.GetIssueWithRepo(parentNum, splitReorderRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue: %w", err)
+	}
+
+	subIssues, err := client.GetSubIssues(parentIssue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	child := findSubIssueByNumber(subIssues, childNum)
+	if child == nil {
+		return fmt.Errorf("#%d is not a sub-issue of #%d", childNum, parentNum)
+	}
+	other := findSubIssueByNumber(subIssues, otherNum)
+	if other == nil {
+		return fmt.Errorf("#%d is not a sub-issue of #%d", otherNum, parentNum)
+	}
+
+	beforeID, afterID := other.ID, ""
+	if position == "after" {
+		beforeID, afterID = "", other.ID
+	}
+	if err := client.ReprioritizeSubIssue(parentIssue.ID, child.ID, beforeID, afterID); err != nil {
+		return err
+	}
+	fmt.Printf("✓ Moved #%d %s #%d in #%d\n", childNum, position, otherNum, parentNum)
+
+	if updated, err := refreshSubIssuesSection(client, parentIssue, splitReorderRepo); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to sync the sub-issue checklist of #%d: %v\n", parentNum, err)
+	} else if updated {
+		fmt.Printf("✓ Synced sub-issue checklist of #%d\n", parentNum)
+	}
+	return nil
+}
+
+// findSubIssueByNumber returns the sub-issue with the given number, if any
+func findSubIssueByNumber(subIssues []issue.SubIssue, number int) *issue.SubIssue {
+	for i := range subIssues {
+		if subIssues[i].Number == number {
+			return &subIssues[i]
+		}
+	}
+	return nil
+}
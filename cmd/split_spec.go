@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	inlineAssigneePattern = regexp.MustCompile(`^@([A-Za-z0-9][A-Za-z0-9-]*)$`)
+	inlineEstimatePattern = regexp.MustCompile(`^~(\d+(?:\.\d+)?[a-zA-Z]*)$`)
+	inlineLabelPattern    = regexp.MustCompile(`^#([A-Za-z][\w./:-]*)$`)
+)
+
+// taskEstimate is a task's estimate; specs may write it as a number or a string
+type taskEstimate string
+
+// UnmarshalJSON accepts both `3` and `"3"`
+func (e *taskEstimate) UnmarshalJSON(data []byte) error {
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err == nil {
+		*e = taskEstimate(number.String())
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("estimate must be a number or a string: %s", string(data))
+	}
+	*e = taskEstimate(text)
+	return nil
+}
+
+// taskSpecFile is the object form of a task spec file
+type taskSpecFile struct {
+	Tasks []*splitTask `yaml:"tasks" json:"tasks"`
+}
+
+// parseInlineTask reads a checklist item such as "Write migration @alice ~3 #backend",
+// moving the trailing assignee, estimate and labels out of the title. Mentions
+// and hashtags before the last plain word stay in the title.
+func parseInlineTask(text string) *splitTask {
+	task := &splitTask{}
+	words := strings.Fields(text)
+	start := len(words)
+	for start > 0 && isInlineToken(words[start-1]) {
+		start--
+	}
+
+	title := words[:start]
+	for _, word := range words[start:] {
+		if m := inlineAssigneePattern.FindStringSubmatch(word); m != nil && task.Assignee == "" {
+			task.Assignee = m[1]
+			continue
+		}
+		if m := inlineEstimatePattern.FindStringSubmatch(word); m != nil && task.Estimate == "" {
+			task.Estimate = taskEstimate(m[1])
+			continue
+		}
+		if m := inlineLabelPattern.FindStringSubmatch(word); m != nil {
+			task.Labels = append(task.Labels, m[1])
+			continue
+		}
+		title = append(title, word)
+	}
+	task.Title = strings.Join(title, " ")
+	return task
+}
+
+// isInlineToken reports whether a word is an inline assignee, estimate or label
+func isInlineToken(word string) bool {
+	return inlineAssigneePattern.MatchString(word) ||
+		inlineEstimatePattern.MatchString(word) ||
+		inlineLabelPattern.MatchString(word)
+}
+
+// parseTaskSpec parses a YAML or JSON task spec: either a list of tasks or an
+// object with a "tasks" list. Every task needs a title.
+func parseTaskSpec(data []byte, format string, opts taskTreeOptions) ([]*splitTask, error) {
+	content := strings.TrimSpace(string(data))
+
+	unmarshal := json.Unmarshal
+	switch format {
+	case "json":
+	case "yaml":
+		unmarshal = yaml.Unmarshal
+	default:
+		return nil, fmt.Errorf("unsupported task spec format: %s", format)
+	}
+
+	var tasks []*splitTask
+	var err error
+	if strings.HasPrefix(content, "[") || strings.HasPrefix(content, "-") {
+		// A plain list of titles is the simplest spec
+		var titles []string
+		if unmarshal([]byte(content), &titles) == nil {
+			return taskLeaves(titles), nil
+		}
+		err = unmarshal([]byte(content), &tasks)
+	} else {
+		var spec taskSpecFile
+		err = unmarshal([]byte(content), &spec)
+		tasks = spec.Tasks
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse task spec: %w", err)
+	}
+
+	if err := validateTaskSpec(tasks, ""); err != nil {
+		return nil, err
+	}
+
+	depth := opts.Depth
+	if depth < 1 {
+		depth = 1
+	}
+	return limitDepth(tasks, depth), nil
+}
+
+// validateTaskSpec checks that every task in a spec has a title
+func validateTaskSpec(tasks []*splitTask, path string) error {
+	for i, task := range tasks {
+		position := fmt.Sprintf("%s%d", path, i+1)
+		if task == nil || strings.TrimSpace(task.Title) == "" {
+			return fmt.Errorf("task %s has no title", position)
+		}
+		task.Title = strings.TrimSpace(task.Title)
+		if err := validateTaskSpec(task.Children, position+"."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// taskSpecFormat returns the spec format for a file name, or "" for markdown/plain text
+func taskSpecFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return "yaml"
+	case ".json":
+		return "json"
+	default:
+		return ""
+	}
+}
+
+// details summarizes a task's own assignee, estimate, labels and fields for previews
+func (t *splitTask) details() string {
+	var parts []string
+	if t.Assignee != "" {
+		parts = append(parts, "@"+t.Assignee)
+	}
+	if t.Estimate != "" {
+		parts = append(parts, "~"+string(t.Estimate))
+	}
+	for _, label := range t.Labels {
+		parts = append(parts, "#"+label)
+	}
+	var fields []string
+	for name, value := range t.Fields {
+		fields = append(fields, fmt.Sprintf("%s: %s", name, value))
+	}
+	sort.Strings(fields)
+	parts = append(parts, fields...)
+	if len(parts) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// hasProjectValues reports whether the task sets an estimate or project fields
+func (t *splitTask) hasProjectValues() bool {
+	return t.Estimate != "" || len(t.Fields) > 0
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func TestParseInlineTask(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected splitTask
+	}{
+		{
+			name:     "plain title",
+			input:    "Write migration",
+			expected: splitTask{Title: "Write migration"},
+		},
+		{
+			name:  "assignee, estimate and label",
+			input: "Write migration @alice ~3 #backend",
+			expected: splitTask{
+				Title:    "Write migration",
+				Assignee: "alice",
+				Estimate: "3",
+				Labels:   []string{"backend"},
+			},
+		},
+		{
+			name:  "only trailing tokens with several labels",
+			input: "#api Add  endpoint ~2.5h #v2 @bob-smith #backend",
+			expected: splitTask{
+				Title:    "#api Add endpoint",
+				Assignee: "bob-smith",
+				Estimate: "2.5h",
+				Labels:   []string{"v2", "backend"},
+			},
+		},
+		{
+			name:     "mentions and hashtags inside the title stay",
+			input:    "Ask @carol about the #naming scheme",
+			expected: splitTask{Title: "Ask @carol about the #naming scheme"},
+		},
+		{
+			name:     "issue references and emails stay in the title",
+			input:    "Follow up on #123 with ops@example.com",
+			expected: splitTask{Title: "Follow up on #123 with ops@example.com"},
+		},
+		{
+			name:     "only the first assignee is taken",
+			input:    "Pair on review @alice @bob",
+			expected: splitTask{Title: "Pair on review @bob", Assignee: "alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, *parseInlineTask(tt.input))
+		})
+	}
+}
+
+func TestParseTaskTreeInlineSyntax(t *testing.T) {
+	tasks := parseTaskTree("- [ ] Write migration @alice ~3 #backend\n- [ ] Docs", taskTreeOptions{Depth: 1})
+
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "Write migration", tasks[0].Title)
+	assert.Equal(t, "alice", tasks[0].Assignee)
+	assert.Equal(t, taskEstimate("3"), tasks[0].Estimate)
+	assert.Equal(t, []string{"backend"}, tasks[0].Labels)
+	assert.Equal(t, "(@alice, ~3, #backend)", tasks[0].details())
+	assert.Equal(t, "", tasks[1].details())
+}
+
+func TestParseTaskSpec(t *testing.T) {
+	yamlSpec := `
+tasks:
+  - title: Backend
+    labels: [backend]
+    tasks:
+      - title: Write migration
+        body: Add the orders table
+        assignee: alice
+        estimate: 3
+        fields:
+          Priority: p1
+      - title: API endpoints
+  - title: Docs
+    estimate: 1.5
+`
+	jsonSpec := `[
+  {"title": "Backend", "labels": ["backend"], "tasks": [
+    {"title": "Write migration", "body": "Add the orders table", "assignee": "alice", "estimate": 3, "fields": {"Priority": "p1"}},
+    {"title": "API endpoints"}
+  ]},
+  {"title": "Docs", "estimate": "1.5"}
+]`
+
+	for _, tc := range []struct{ format, spec string }{{"yaml", yamlSpec}, {"json", jsonSpec}} {
+		t.Run(tc.format, func(t *testing.T) {
+			tasks, err := parseTaskSpec([]byte(tc.spec), tc.format, taskTreeOptions{Depth: 2})
+			require.NoError(t, err)
+			assert.Equal(t, "Backend(Write migration,API endpoints),Docs", treeShape(tasks))
+
+			migration := tasks[0].Children[0]
+			assert.Equal(t, "Add the orders table", migration.Body)
+			assert.Equal(t, "alice", migration.Assignee)
+			assert.Equal(t, taskEstimate("3"), migration.Estimate)
+			assert.Equal(t, map[string]string{"Priority": "p1"}, migration.Fields)
+			assert.Equal(t, []string{"backend"}, tasks[0].Labels)
+			assert.Equal(t, taskEstimate("1.5"), tasks[1].Estimate)
+		})
+	}
+}
+
+func TestParseTaskSpecDepthAndErrors(t *testing.T) {
+	spec := "- title: A\n  tasks:\n    - title: B\n      tasks:\n        - title: C\n- title: D\n"
+
+	tasks, err := parseTaskSpec([]byte(spec), "yaml", taskTreeOptions{Depth: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "A,B,C,D", treeShape(tasks))
+
+	tasks, err = parseTaskSpec([]byte(spec), "yaml", taskTreeOptions{Depth: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "A(B,C),D", treeShape(tasks))
+
+	tasks, err = parseTaskSpec([]byte(`["One @alice", "Two"]`), "json", taskTreeOptions{Depth: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "One,Two", treeShape(tasks))
+	assert.Equal(t, "alice", tasks[0].Assignee)
+
+	_, err = parseTaskSpec([]byte("- title: A\n  tasks:\n    - body: no title\n"), "yaml", taskTreeOptions{Depth: 2})
+	assert.EqualError(t, err, "task 1.1 has no title")
+
+	_, err = parseTaskSpec([]byte(`[{"title": "A", "estimate": true}]`), "json", taskTreeOptions{Depth: 1})
+	assert.Error(t, err)
+}
+
+func TestExtractTasksFromSpecFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	specFile := filepath.Join(tmpDir, "tasks.yml")
+	require.NoError(t, os.WriteFile(specFile, []byte("- title: Task 1\n  labels: [api]\n- title: Task 2\n"), 0644))
+
+	tasks, err := extractTasksFromFile(specFile, taskTreeOptions{Depth: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Task 1", "Task 2"}, taskTitles(tasks))
+	assert.Equal(t, []string{"api"}, tasks[0].Labels)
+
+	tasks, err = extractTasksFromReader(strings.NewReader(`{"tasks": [{"title": "From stdin", "assignee": "bob"}]}`), taskTreeOptions{Depth: 1})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "bob", tasks[0].Assignee)
+}
+
+func TestSubIssueLabels(t *testing.T) {
+	parent := issue.Issue{Labels: []issue.Label{{Name: "epic"}, {Name: "backend"}, {Name: "q3"}}}
+
+	labels := subIssueLabels(parent, &splitTask{Labels: []string{"Backend", "db"}})
+
+	var names []string
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	assert.Equal(t, []string{"backend", "q3", "db"}, names)
+}
+
+func TestDropUnknownLabels(t *testing.T) {
+	tasks := []*splitTask{
+		{Title: "API", Labels: []string{"Backend", "nope"}, Children: []*splitTask{
+			{Title: "Schema", Labels: []string{"db"}},
+		}},
+		{Title: "Docs"},
+	}
+	var warnings strings.Builder
+
+	assert.True(t, hasTaskLabels(tasks))
+	dropUnknownLabels(tasks, []string{"backend", "docs"}, &warnings)
+
+	assert.Equal(t, []string{"Backend"}, tasks[0].Labels)
+	assert.Empty(t, tasks[0].Children[0].Labels)
+	assert.False(t, hasTaskLabels(tasks[1:]))
+	assert.Equal(t, "Warning: label 'nope' does not exist; creating 'API' without it\n"+
+		"Warning: label 'db' does not exist; creating 'Schema' without it\n", warnings.String())
+}
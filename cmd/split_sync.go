@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+)
+
+const subIssuesHeading = "## Sub-Issues"
+
+var (
+	subIssuesHeadingPattern = regexp.MustCompile(`^##\s+Sub-Issues\s*$`)
+	markdownHeadingPattern  = regexp.MustCompile(`^#{1,6}\s`)
+)
+
+var (
+	splitSyncRepo      string
+	splitSyncDryRun    bool
+	splitSyncSyncState bool
+)
+
+// splitSyncCmd rewrites a parent's sub-issue checklist from its live sub-issues
+var splitSyncCmd = &cobra.Command{
+	Use:   "sync [issue number]",
+	Short: "Sync the parent's sub-issue checklist with its sub-issues",
+	Long: `Rewrite the "## Sub-Issues" section of a parent issue from its current
+sub-issues: closed sub-issues are ticked, newly linked ones are added and
+removed ones are dropped. The rest of the issue body is left untouched.
+
+Examples:
+  # Refresh the checklist of issue 123
+  gh pm split sync 123
+
+  # Preview the new checklist without editing the issue
+  gh pm split sync 123 --dry-run
+
+  # Also close the parent when every sub-issue is closed (and reopen it otherwise)
+  gh pm split sync 123 --sync-state`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("requires exactly 1 argument")
+		}
+		if _, err := strconv.Atoi(args[0]); err != nil {
+			return fmt.Errorf("invalid issue number: %s", args[0])
+		}
+		return nil
+	},
+	RunE: runSplitSync,
+}
+
+func init() {
+	splitCmd.AddCommand(splitSyncCmd)
+	splitSyncCmd.Flags().StringVar(&splitSyncRepo, "repo", "", "Repository (owner/repo format)")
+	splitSyncCmd.Flags().BoolVar(&splitSyncDryRun, "dry-run", false, "Preview the updated checklist without making changes")
+	splitSyncCmd.Flags().BoolVar(&splitSyncSyncState, "sync-state", false, "Close the parent when all sub-issues are closed, and reopen it when one is open")
+}
+
+func runSplitSync(cmd *cobra.Command, args []string) error {
+	issueNum, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[0])
+	}
+
+	client := issue.NewClient()
+	parentIssue, err := client.GetIssueWithRepo(issueNum, splitSyncRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue: %w", err)
+	}
+
+	subIssues, err := client.GetSubIssues(parentIssue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	section := renderSubIssuesSection(subIssues)
+	updatedBody := replaceSubIssuesSection(parentIssue.Body, section)
+	bodyChanged := updatedBody != parentIssue.Body
+	stateAction := ""
+	if splitSyncSyncState {
+		stateAction = parentStateAction(parentIssue.State, subIssues)
+	}
+
+	closed := countClosedSubIssues(subIssues)
+
+	if splitSyncDryRun {
+		if outputFormat == "json" {
+			return output.NewFormatter(output.FormatJSON).Format(map[string]interface{}{
+				"dry_run":      true,
+				"parent_issue": issueNum,
+				"sub_issues":   len(subIssues),
+				"closed":       closed,
+				"body_changed": bodyChanged,
+				"state_action": stateAction,
+				"section":      section,
+			})
+		}
+		fmt.Printf("🔍 DRY-RUN: #%d %s (%d/%d sub-issues closed)\n\n", parentIssue.Number, parentIssue.Title, closed, len(subIssues))
+		fmt.Print(section)
+		if !bodyChanged {
+			fmt.Println("\n→ Checklist is already up to date")
+		}
+		if stateAction != "" {
+			fmt.Printf("→ Would %s #%d\n", stateAction, parentIssue.Number)
+		}
+		return nil
+	}
+
+	if bodyChanged {
+		if err := client.UpdateIssueWithRepo(parentIssue.Number, issue.IssueRequest{Body: updatedBody}, splitSyncRepo); err != nil {
+			return fmt.Errorf("failed to update parent issue: %w", err)
+		}
+	}
+
+	switch stateAction {
+	case "close":
+		err = client.CloseIssueWithRepo(parentIssue.Number, splitSyncRepo)
+	case "reopen":
+		err = client.ReopenIssueWithRepo(parentIssue.Number, splitSyncRepo)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s parent issue: %w", stateAction, err)
+	}
+
+	if outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(map[string]interface{}{
+			"parent_issue": issueNum,
+			"sub_issues":   len(subIssues),
+			"closed":       closed,
+			"body_changed": bodyChanged,
+			"state_action": stateAction,
+		})
+	}
+
+	if bodyChanged {
+		fmt.Printf("✓ Synced sub-issue checklist of #%d (%d/%d closed)\n", parentIssue.Number, closed, len(subIssues))
+	} else {
+		fmt.Printf("Sub-issue checklist of #%d is already up to date (%d/%d closed)\n", parentIssue.Number, closed, len(subIssues))
+	}
+	switch stateAction {
+	case "close":
+		fmt.Printf("✓ Closed #%d: all sub-issues are closed\n", parentIssue.Number)
+	case "reopen":
+		fmt.Printf("✓ Reopened #%d: it has open sub-issues\n", parentIssue.Number)
+	}
+	return nil
+}
+
+// renderSubIssuesSection builds the "## Sub-Issues" checklist, ticking closed sub-issues
+func renderSubIssuesSection(subIssues []issue.SubIssue) string {
+	var b strings.Builder
+	b.WriteString(subIssuesHeading + "\n")
+	for _, subIssue := range subIssues {
+		check := " "
+		if strings.EqualFold(subIssue.State, "CLOSED") {
+			check = "x"
+		}
+		fmt.Fprintf(&b, "- [%s] #%d %s\n", check, subIssue.Number, subIssue.Title)
+	}
+	return b.String()
+}
+
+// replaceSubIssuesSection swaps the body's "## Sub-Issues" section for the
+// given one, or appends it. The section ends at the next heading, which is kept.
+func replaceSubIssuesSection(body, section string) string {
+	lines := strings.Split(body, "\n")
+
+	start := -1
+	for i, line := range lines {
+		if subIssuesHeadingPattern.MatchString(strings.TrimSpace(line)) {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		if strings.TrimSpace(body) == "" {
+			return section
+		}
+		return strings.TrimRight(body, "\n") + "\n\n" + section
+	}
+
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		if markdownHeadingPattern.MatchString(lines[i]) {
+			end = i
+			break
+		}
+	}
+
+	before := strings.Join(lines[:start], "\n")
+	if start > 0 {
+		before += "\n"
+	}
+	if end == len(lines) {
+		return before + section
+	}
+	return before + section + "\n" + strings.Join(lines[end:], "\n")
+}
+
+// parentStateAction returns "close" when every sub-issue of an open parent is
+// closed, "reopen" when a closed parent has open sub-issues, or ""
+func parentStateAction(parentState string, subIssues []issue.SubIssue) string {
+	if len(subIssues) == 0 {
+		return ""
+	}
+	allClosed := countClosedSubIssues(subIssues) == len(subIssues)
+	parentClosed := strings.EqualFold(parentState, "CLOSED")
+
+	switch {
+	case allClosed && !parentClosed:
+		return "close"
+	case !allClosed && parentClosed:
+		return "reopen"
+	default:
+		return ""
+	}
+}
+
+// countClosedSubIssues counts the closed sub-issues
+func countClosedSubIssues(subIssues []issue.SubIssue) int {
+	closed := 0
+	for _, subIssue := range subIssues {
+		if strings.EqualFold(subIssue.State, "CLOSED") {
+			closed++
+		}
+	}
+	return closed
+}
+
+// hasSubIssuesSection reports whether the body has a "## Sub-Issues" section
+func hasSubIssuesSection(body string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		if subIssuesHeadingPattern.MatchString(strings.TrimSpace(line)) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshSubIssuesSection rewrites the parent's "## Sub-Issues" section from
+// its current sub-issues. Parents without such a section are left alone.
+func refreshSubIssuesSection(client *issue.Client, parentIssue issue.Issue, repo string) (bool, error) {
+	if !hasSubIssuesSection(parentIssue.Body) {
+		return false, nil
+	}
+
+	subIssues, err := client.GetSubIssues(parentIssue.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	updatedBody := replaceSubIssuesSection(parentIssue.Body, renderSubIssuesSection(subIssues))
+	if updatedBody == parentIssue.Body {
+		return false, nil
+	}
+	if err := client.UpdateIssueWithRepo(parentIssue.Number, issue.IssueRequest{Body: updatedBody}, repo); err != nil {
+		return false, fmt.Errorf("failed to update parent issue: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func TestRenderSubIssuesSection(t *testing.T) {
+	section := renderSubIssuesSection([]issue.SubIssue{
+		{Number: 12, Title: "Design schema", State: "CLOSED"},
+		{Number: 13, Title: "Build API", State: "OPEN"},
+	})
+
+	assert.Equal(t, "## Sub-Issues\n- [x] #12 Design schema\n- [ ] #13 Build API\n", section)
+}
+
+func TestReplaceSubIssuesSection(t *testing.T) {
+	section := "## Sub-Issues\n- [x] #12 Design schema\n- [ ] #14 New task\n"
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "empty body",
+			body:     "",
+			expected: section,
+		},
+		{
+			name:     "appends when missing",
+			body:     "Epic description\n",
+			expected: "Epic description\n\n" + section,
+		},
+		{
+			name:     "replaces a trailing section",
+			body:     "Intro\n\n## Sub-Issues\n- [ ] #12 Design schema\n",
+			expected: "Intro\n\n" + section,
+		},
+		{
+			name:     "keeps the following heading and its content",
+			body:     "Intro\n\n## Sub-Issues\n- [ ] #12 Design schema\n\n## Notes\nKeep me\n",
+			expected: "Intro\n\n" + section + "\n## Notes\nKeep me\n",
+		},
+		{
+			name:     "keeps a deeper following heading",
+			body:     "## Sub-Issues\n- [ ] #12 Design schema\n### Details\nMore\n",
+			expected: section + "\n### Details\nMore\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, replaceSubIssuesSection(tt.body, section))
+		})
+	}
+}
+
+func TestParentStateAction(t *testing.T) {
+	allClosed := []issue.SubIssue{{State: "CLOSED"}, {State: "CLOSED"}}
+	someOpen := []issue.SubIssue{{State: "CLOSED"}, {State: "OPEN"}}
+
+	tests := []struct {
+		name      string
+		state     string
+		subIssues []issue.SubIssue
+		expected  string
+	}{
+		{"open parent, all done", "OPEN", allClosed, "close"},
+		{"open parent, work left", "OPEN", someOpen, ""},
+		{"closed parent, reopened child", "CLOSED", someOpen, "reopen"},
+		{"closed parent, all done", "CLOSED", allClosed, ""},
+		{"no sub-issues", "OPEN", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parentStateAction(tt.state, tt.subIssues))
+		})
+	}
+}
+
+func TestHasSubIssuesSection(t *testing.T) {
+	assert.True(t, hasSubIssuesSection("Intro\n\n## Sub-Issues\n- [ ] #1 Task\n"))
+	assert.True(t, hasSubIssuesSection("##  Sub-Issues  \n"))
+	assert.False(t, hasSubIssuesSection("### Sub-Issues\n"))
+	assert.False(t, hasSubIssuesSection("Mentions ## Sub-Issues inline"))
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
 )
 
 func TestExtractChecklistItems(t *testing.T) {
@@ -93,11 +95,11 @@ Some text here
 	}
 }
 
-func TestIsTaskAlreadySubIssue(t *testing.T) {
-	existingSubIssues := []SubIssueInfo{
-		{Number: 1, State: "open", Title: "Design database schema"},
-		{Number: 2, State: "open", Title: "Implement API endpoints"},
-		{Number: 3, State: "closed", Title: "Write unit tests"},
+func TestFindExistingSubIssue(t *testing.T) {
+	existingSubIssues := []issue.SubIssue{
+		{Number: 1, State: "OPEN", Title: "Design database schema"},
+		{Number: 2, State: "OPEN", Title: "Implement API endpoints"},
+		{Number: 3, State: "CLOSED", Title: "Write unit tests"},
 	}
 
 	tests := []struct {
@@ -134,7 +136,7 @@ func TestIsTaskAlreadySubIssue(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := isTaskAlreadySubIssue(tt.task, existingSubIssues)
+			result := findExistingSubIssue(tt.task, existingSubIssues) != nil
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -154,12 +156,12 @@ func TestExtractTasksFromFile(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test file extraction
-	tasks, err := extractTasksFromFile(testFile)
+	tasks, err := extractTasksFromFile(testFile, taskTreeOptions{Depth: 1})
 	assert.NoError(t, err)
-	assert.Equal(t, []string{"Task 1", "Task 2", "Task 3"}, tasks)
+	assert.Equal(t, []string{"Task 1", "Task 2", "Task 3"}, taskTitles(tasks))
 
 	// Test non-existent file
-	_, err = extractTasksFromFile("/non/existent/file.md")
+	_, err = extractTasksFromFile("/non/existent/file.md", taskTreeOptions{Depth: 1})
 	assert.Error(t, err)
 }
 
@@ -196,22 +198,13 @@ func TestExtractTasksFromReader(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			reader := strings.NewReader(tt.input)
-			result, err := extractTasksFromReader(reader)
+			result, err := extractTasksFromReader(reader, taskTreeOptions{Depth: 1})
 			assert.NoError(t, err)
-			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expected, taskTitles(result))
 		})
 	}
 }
 
-func TestIsGhSubIssueInstalled(t *testing.T) {
-	// This test might fail depending on the actual environment
-	// We're mainly testing that the function doesn't panic
-	t.Run("check installation", func(t *testing.T) {
-		// Just ensure the function runs without panic
-		_ = isGhSubIssueInstalled()
-	})
-}
-
 func TestSplitCommandFlags(t *testing.T) {
 	// Reset flags for testing
 	splitFrom = ""
@@ -282,3 +275,99 @@ func TestRunSplitDryRun(t *testing.T) {
 		assert.True(t, splitDryRun)
 	})
 }
+
+// treeShape renders tasks as "title(child,child)" for compact comparisons
+func treeShape(tasks []*splitTask) string {
+	parts := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		if len(task.Children) > 0 {
+			parts = append(parts, task.Title+"("+treeShape(task.Children)+")")
+		} else {
+			parts = append(parts, task.Title)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestParseTaskTree(t *testing.T) {
+	body := `# Epic
+Intro text
+
+## Backend
+- [ ] API
+  - [ ] Endpoints
+    - [ ] Pagination
+  - [x] Auth
+- [ ] Migration
+
+## Frontend
+- [ ] Page
+	- [ ] Form
+
+## Notes
+Nothing to do here
+`
+
+	tests := []struct {
+		name     string
+		opts     taskTreeOptions
+		expected string
+	}{
+		{
+			name:     "depth 1 flattens everything",
+			opts:     taskTreeOptions{Depth: 1},
+			expected: "API,Endpoints,Pagination,Auth,Migration,Page,Form",
+		},
+		{
+			name:     "depth 2 nests by indentation",
+			opts:     taskTreeOptions{Depth: 2},
+			expected: "API(Endpoints,Pagination,Auth),Migration,Page(Form)",
+		},
+		{
+			name:     "depth 3 keeps the full checklist hierarchy",
+			opts:     taskTreeOptions{Depth: 3},
+			expected: "API(Endpoints(Pagination),Auth),Migration,Page(Form)",
+		},
+		{
+			name:     "headings become parents and empty sections are dropped",
+			opts:     taskTreeOptions{Depth: 3, Headings: true},
+			expected: "Backend(API(Endpoints,Pagination,Auth),Migration),Frontend(Page(Form))",
+		},
+		{
+			name:     "headings with depth 2 flatten checklist nesting",
+			opts:     taskTreeOptions{Depth: 2, Headings: true},
+			expected: "Backend(API,Endpoints,Pagination,Auth,Migration),Frontend(Page,Form)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, treeShape(parseTaskTree(body, tt.opts)))
+		})
+	}
+}
+
+func TestParseTaskTreeNestedHeadings(t *testing.T) {
+	body := `## Phase 1
+### Setup
+- [ ] Repo
+### Build
+- [ ] Service
+## Phase 2
+- [ ] Launch
+`
+	tasks := parseTaskTree(body, taskTreeOptions{Depth: 3, Headings: true})
+	assert.Equal(t, "Phase 1(Setup(Repo),Build(Service)),Phase 2(Launch)", treeShape(tasks))
+}
+
+func TestRenderTaskTree(t *testing.T) {
+	tasks := parseTaskTree("- [ ] A\n  - [ ] A1\n  - [ ] A2\n- [ ] B\n  - [ ] B1", taskTreeOptions{Depth: 2})
+
+	assert.Equal(t, []string{
+		"├── A",
+		"│   ├── A1",
+		"│   └── A2",
+		"└── B",
+		"    └── B1",
+	}, renderTaskTree(tasks, ""))
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	taskCheckboxPattern = regexp.MustCompile(`^(\s*)[-*]\s*\[[ xX]\]\s*(.+)`)
+	taskHeadingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+)
+
+// splitTask is a task to turn into a sub-issue, with its own sub-tasks
+type splitTask struct {
+	Title    string            `yaml:"title" json:"title"`
+	Body     string            `yaml:"body,omitempty" json:"body,omitempty"`
+	Labels   []string          `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Assignee string            `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	Estimate taskEstimate      `yaml:"estimate,omitempty" json:"estimate,omitempty"`
+	Fields   map[string]string `yaml:"fields,omitempty" json:"fields,omitempty"`
+	Children []*splitTask      `yaml:"tasks,omitempty" json:"tasks,omitempty"`
+	heading  bool
+}
+
+// taskTreeOptions controls how nested checklists are turned into a hierarchy
+type taskTreeOptions struct {
+	Depth    int  // Maximum levels of sub-issues; deeper items are attached at the last level
+	Headings bool // Use markdown headings as intermediate parents
+}
+
+// parseTaskTree builds a task hierarchy from checklist items, nesting them by
+// indentation and, when enabled, under the headings they appear beneath
+func parseTaskTree(text string, opts taskTreeOptions) []*splitTask {
+	maxLevel := opts.Depth - 1
+	if maxLevel < 0 {
+		maxLevel = 0
+	}
+
+	type entry struct {
+		level int
+		task  *splitTask
+	}
+
+	var roots []*splitTask
+	var stack []entry
+	sectionLevel := -1
+
+	add := func(level int, task *splitTask) {
+		if level > maxLevel {
+			level = maxLevel
+		}
+		for len(stack) > 0 && stack[len(stack)-1].level >= level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, task)
+		} else {
+			parent := stack[len(stack)-1].task
+			parent.Children = append(parent.Children, task)
+		}
+		stack = append(stack, entry{level: level, task: task})
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		if opts.Headings {
+			if m := taskHeadingPattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				// "#" and "##" are top-level sections, "###" nests under them, and so on
+				sectionLevel = len(m[1]) - 2
+				if sectionLevel < 0 {
+					sectionLevel = 0
+				}
+				add(sectionLevel, &splitTask{Title: strings.TrimSpace(m[2]), heading: true})
+				continue
+			}
+		}
+
+		m := taskCheckboxPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		task := parseInlineTask(m[2])
+		if task.Title == "" {
+			continue
+		}
+
+		indent := len(strings.ReplaceAll(m[1], "\t", "  ")) / 2
+		add(sectionLevel+1+indent, task)
+	}
+
+	return pruneEmptyHeadings(roots)
+}
+
+// pruneEmptyHeadings drops headings that ended up without any tasks beneath them
+func pruneEmptyHeadings(tasks []*splitTask) []*splitTask {
+	pruned := []*splitTask{}
+	for _, task := range tasks {
+		task.Children = pruneEmptyHeadings(task.Children)
+		if task.heading && len(task.Children) == 0 {
+			continue
+		}
+		pruned = append(pruned, task)
+	}
+	return pruned
+}
+
+// taskLeaves wraps plain task titles, which may use the inline syntax, as a flat task list
+func taskLeaves(titles []string) []*splitTask {
+	tasks := make([]*splitTask, 0, len(titles))
+	for _, title := range titles {
+		tasks = append(tasks, parseInlineTask(title))
+	}
+	return tasks
+}
+
+// limitDepth attaches tasks nested deeper than depth levels at the last level
+func limitDepth(tasks []*splitTask, depth int) []*splitTask {
+	if depth <= 1 {
+		flat := []*splitTask{}
+		for _, task := range tasks {
+			flat = append(flat, flattenTasks(task)...)
+		}
+		return flat
+	}
+	for _, task := range tasks {
+		task.Children = limitDepth(task.Children, depth-1)
+	}
+	return tasks
+}
+
+// flattenTasks returns a task and all of its descendants, depth-first, without children
+func flattenTasks(task *splitTask) []*splitTask {
+	children := task.Children
+	task.Children = nil
+	flat := []*splitTask{task}
+	for _, child := range children {
+		flat = append(flat, flattenTasks(child)...)
+	}
+	return flat
+}
+
+// taskTitles returns every task title in depth-first order
+func taskTitles(tasks []*splitTask) []string {
+	titles := []string{}
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+		titles = append(titles, taskTitles(task.Children)...)
+	}
+	return titles
+}
+
+// isNested reports whether any task has sub-tasks
+func isNested(tasks []*splitTask) bool {
+	for _, task := range tasks {
+		if len(task.Children) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// renderTaskTree draws the tasks as an indented tree below a root line
+func renderTaskTree(tasks []*splitTask, prefix string) []string {
+	var lines []string
+	for i, task := range tasks {
+		branch, indent := "├── ", "│   "
+		if i == len(tasks)-1 {
+			branch, indent = "└── ", "    "
+		}
+		line := prefix + branch + task.Title
+		if details := task.details(); details != "" {
+			line += "  " + details
+		}
+		lines = append(lines, line)
+		lines = append(lines, renderTaskTree(task.Children, prefix+indent)...)
+	}
+	return lines
+}
@@ -0,0 +1,572 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var (
+	sprintField  string
+	sprintLimit  int
+	sprintTo     string
+	sprintDryRun bool
+)
+
+// sprintCmd groups the iteration commands
+var sprintCmd = &cobra.Command{
+	Use:   "sprint",
+	Short: "Manage project iterations (sprints)",
+	Long: `Show and manage the iterations of the project's iteration field.
+
+The iteration field is taken from fields.iteration.field in .gh-pm.yml, or
+--field, and defaults to the project's first iteration field. Iterations can be
+named by title or as @current, @next or @previous.`,
+}
+
+var sprintListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List iterations with their item counts and estimates",
+	Args:  cobra.NoArgs,
+	RunE:  runSprintList,
+}
+
+var sprintCurrentCmd = &cobra.Command{
+	Use:   "current",
+	Short: "Show the items of the current iteration",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSprintShow(cmd, []string{"@current"})
+	},
+}
+
+var sprintShowCmd = &cobra.Command{
+	Use:   "show [iteration]",
+	Short: "Show the items of an iteration grouped by status",
+	Example: `  # The current iteration
+  gh pm sprint show
+
+  # By title
+  gh pm sprint show "Sprint 12"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSprintShow,
+}
+
+var sprintRolloverCmd = &cobra.Command{
+	Use:   "rollover [iteration]",
+	Short: "Move the unfinished items of an iteration into the next one",
+	Long: `Move every unfinished item of an iteration into the next iteration, or the
+one given with --to. Items are finished when they are closed or in the "done"
+status.
+
+By default the iteration that is ending is rolled over: the current one on
+its last day, otherwise the last iteration that has ended.
+
+Each moved item gets a comment naming the iterations it moved between, so
+'gh pm velocity' still counts it as committed to the iteration it left.`,
+	Example: `  # At the end of the sprint
+  gh pm sprint rollover
+
+  # Preview the moves
+  gh pm sprint rollover --dry-run
+
+  # Into a specific iteration
+  gh pm sprint rollover "Sprint 12" --to "Sprint 14"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSprintRollover,
+}
+
+func init() {
+	rootCmd.AddCommand(sprintCmd)
+	sprintCmd.AddCommand(sprintListCmd, sprintCurrentCmd, sprintShowCmd, sprintRolloverCmd)
+
+	addIterationFlags(sprintCmd)
+	sprintRolloverCmd.Flags().StringVar(&sprintTo, "to", "@next", "Iteration to move unfinished items into")
+	sprintRolloverCmd.Flags().BoolVar(&sprintDryRun, "dry-run", false, "Show the items that would move without changing them")
+}
+
+// addIterationFlags defines the --field and --limit flags shared by the
+// commands that read an iteration field and its items
+func addIterationFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&sprintField, "field", "", "Iteration field to use")
+	cmd.PersistentFlags().IntVarP(&sprintLimit, "limit", "L", 500, "Maximum number of project items to read")
+}
+
+// SprintCommand holds the project, its iteration field and items
+type SprintCommand struct {
+	config    *config.Config
+	client    *project.Client
+	issueAPI  *issue.Client
+	projectID string
+	field     *project.IterationField
+	items     []filter.ProjectIssue
+	now       time.Time
+}
+
+// sprintSummary describes one iteration
+type sprintSummary struct {
+	ID        string  `json:"id"`
+	Title     string  `json:"title"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"` // Last day of the iteration
+	Duration  int     `json:"duration"`
+	State     string  `json:"state"` // completed, current or upcoming
+	Items     int     `json:"items"`
+	Finished  int     `json:"finished"`
+	Estimate  float64 `json:"estimate"`
+}
+
+// sprintItem is a project item of an iteration
+type sprintItem struct {
+	Number    int      `json:"number"`
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	Assignees []string `json:"assignees"`
+	Estimate  *float64 `json:"estimate,omitempty"`
+}
+
+// sprintStatusGroup is the items of an iteration in one status
+type sprintStatusGroup struct {
+	Status   string       `json:"status"`
+	Estimate float64      `json:"estimate"`
+	Items    []sprintItem `json:"items"`
+}
+
+// sprintDetail is an iteration with its items grouped by status
+type sprintDetail struct {
+	sprintSummary
+	Groups []sprintStatusGroup `json:"groups"`
+}
+
+// newSprintCommand loads the project, the iteration field and the project items
+func newSprintCommand() (*SprintCommand, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return nil, fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+
+	client, err := project.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, client)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := client.GetIterationFields(projectID)
+	if err != nil {
+		return nil, err
+	}
+	field, err := selectIterationField(fields, iterationFieldName(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, sprintLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	return &SprintCommand{
+		config:    cfg,
+		client:    client,
+		issueAPI:  issue.NewClient(),
+		projectID: projectID,
+		field:     field,
+		items:     items,
+		now:       time.Now(),
+	}, nil
+}
+
+// iterationFieldName returns the iteration field from --field or the config,
+// or "" to use the project's first iteration field
+func iterationFieldName(cfg *config.Config) string {
+	if sprintField != "" {
+		return sprintField
+	}
+	if iteration, ok := cfg.Fields["iteration"]; ok {
+		return iteration.Field
+	}
+	return ""
+}
+
+// estimateFieldName returns the project field configured for estimates
+func estimateFieldName(cfg *config.Config) string {
+	if estimate, ok := cfg.Fields["estimate"]; ok && estimate.Field != "" {
+		return estimate.Field
+	}
+	return "Estimate"
+}
+
+// selectIterationField picks the named iteration field, or the first one
+func selectIterationField(fields []project.IterationField, name string) (*project.IterationField, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("the project has no iteration field")
+	}
+	if name == "" {
+		return &fields[0], nil
+	}
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			return &fields[i], nil
+		}
+	}
+	return nil, fmt.Errorf("iteration field '%s' not found in project", name)
+}
+
+// itemsIn returns the project items assigned to the iteration
+func (c *SprintCommand) itemsIn(iteration project.Iteration) []filter.ProjectIssue {
+	var items []filter.ProjectIssue
+	for _, item := range c.items {
+		if item.IterationIDs[c.field.Name] == iteration.ID {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// itemEstimate returns the item's estimate, if it has one
+func itemEstimate(cfg *config.Config, item filter.ProjectIssue) *float64 {
+	if value, ok := item.Fields[estimateFieldName(cfg)].(float64); ok {
+		return &value
+	}
+	return nil
+}
+
+// isItemFinished reports whether the item is closed or in the done status
+func isItemFinished(cfg *config.Config, item filter.ProjectIssue) bool {
+	if strings.EqualFold(item.State, "closed") {
+		return true
+	}
+	status, _ := item.Fields[statusFieldName(cfg)].(string)
+	return status != "" && strings.EqualFold(status, statusOptionName(cfg, "done", "Done"))
+}
+
+// summarize counts the items and estimates of an iteration
+func (c *SprintCommand) summarize(iteration project.Iteration) sprintSummary {
+	summary := sprintSummary{
+		ID:        iteration.ID,
+		Title:     iteration.Title,
+		StartDate: iteration.StartDate,
+		EndDate:   iteration.End().AddDate(0, 0, -1).Format("2006-01-02"),
+		Duration:  iteration.Duration,
+		State:     iterationState(iteration, c.now),
+	}
+	for _, item := range c.itemsIn(iteration) {
+		summary.Items++
+		if isItemFinished(c.config, item) {
+			summary.Finished++
+		}
+		if estimate := itemEstimate(c.config, item); estimate != nil {
+			summary.Estimate += *estimate
+		}
+	}
+	return summary
+}
+
+// iterationState returns completed, current or upcoming
+func iterationState(iteration project.Iteration, now time.Time) string {
+	switch {
+	case iteration.Contains(now):
+		return "current"
+	case iteration.Completed || !now.Before(iteration.End()):
+		return "completed"
+	default:
+		return "upcoming"
+	}
+}
+
+// groupByStatus groups items by status, in the order of the status options;
+// items without a status come last
+func groupByStatus(cfg *config.Config, items []filter.ProjectIssue, statusOrder []string) []sprintStatusGroup {
+	index := make(map[string]int)
+	var groups []sprintStatusGroup
+	for _, status := range statusOrder {
+		index[strings.ToLower(status)] = len(groups)
+		groups = append(groups, sprintStatusGroup{Status: status})
+	}
+
+	statusField := statusFieldName(cfg)
+	for _, item := range items {
+		status, _ := item.Fields[statusField].(string)
+		if status == "" {
+			status = "(no status)"
+		}
+		i, ok := index[strings.ToLower(status)]
+		if !ok {
+			i = len(groups)
+			index[strings.ToLower(status)] = i
+			groups = append(groups, sprintStatusGroup{Status: status})
+		}
+
+		entry := sprintItem{
+			Number:    item.Number,
+			Title:     item.Title,
+			State:     item.State,
+			Assignees: item.Assignees,
+			Estimate:  itemEstimate(cfg, item),
+		}
+		if entry.Assignees == nil {
+			entry.Assignees = []string{}
+		}
+		if entry.Estimate != nil {
+			groups[i].Estimate += *entry.Estimate
+		}
+		groups[i].Items = append(groups[i].Items, entry)
+	}
+
+	// Drop the statuses without items, keeping the "(no status)" group last
+	var result []sprintStatusGroup
+	var noStatus *sprintStatusGroup
+	for i := range groups {
+		switch {
+		case len(groups[i].Items) == 0:
+		case groups[i].Status == "(no status)":
+			noStatus = &groups[i]
+		default:
+			result = append(result, groups[i])
+		}
+	}
+	if noStatus != nil {
+		result = append(result, *noStatus)
+	}
+	return result
+}
+
+// statusOrder returns the option names of the status field, in board order
+func (c *SprintCommand) statusOrder() []string {
+	fields, err := loadProjectFields(c.config, c.client, c.projectID)
+	if err != nil {
+		return nil
+	}
+	field := findFieldByName(fields, statusFieldName(c.config))
+	if field == nil {
+		return nil
+	}
+	var order []string
+	for _, option := range field.Options {
+		order = append(order, option.Name)
+	}
+	return order
+}
+
+func runSprintList(cmd *cobra.Command, args []string) error {
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+
+	summaries := []sprintSummary{}
+	for _, iteration := range command.field.Iterations {
+		summaries = append(summaries, command.summarize(iteration))
+	}
+
+	if outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(summaries)
+	}
+
+	if len(summaries) == 0 {
+		fmt.Printf("'%s' has no iterations\n", command.field.Name)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ITERATION\tSTART\tEND\tSTATE\tITEMS\tDONE\tESTIMATE\n")
+	for _, summary := range summaries {
+		title := summary.Title
+		if summary.State == "current" {
+			title = "▶ " + title
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n", title, summary.StartDate, summary.EndDate,
+			summary.State, summary.Items, summary.Finished, formatNumber(summary.Estimate))
+	}
+	return w.Flush()
+}
+
+func runSprintShow(cmd *cobra.Command, args []string) error {
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+
+	name := "@current"
+	if len(args) > 0 {
+		name = args[0]
+	}
+	iteration, err := command.field.Resolve(name, command.now)
+	if err != nil {
+		return err
+	}
+
+	detail := sprintDetail{
+		sprintSummary: command.summarize(*iteration),
+		Groups:        groupByStatus(command.config, command.itemsIn(*iteration), command.statusOrder()),
+	}
+	if detail.Groups == nil {
+		detail.Groups = []sprintStatusGroup{}
+	}
+
+	if outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(detail)
+	}
+
+	fmt.Printf("%s  (%s → %s", detail.Title, detail.StartDate, detail.EndDate)
+	if detail.State == "current" {
+		day := int(command.now.Sub(iteration.Start()).Hours()/24) + 1
+		fmt.Printf(", day %d of %d", day, detail.Duration)
+	} else {
+		fmt.Printf(", %s", detail.State)
+	}
+	fmt.Printf(")\n%d items, %d done, estimate %s\n", detail.Items, detail.Finished, formatNumber(detail.Estimate))
+
+	for _, group := range detail.Groups {
+		fmt.Printf("\n%s (%d, estimate %s)\n", group.Status, len(group.Items), formatNumber(group.Estimate))
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, item := range group.Items {
+			assignees := "-"
+			if len(item.Assignees) > 0 {
+				assignees = "@" + strings.Join(item.Assignees, ", @")
+			}
+			estimate := "-"
+			if item.Estimate != nil {
+				estimate = formatNumber(*item.Estimate)
+			}
+			fmt.Fprintf(w, "  #%d\t%s\t%s\t%s\n", item.Number, truncate(item.Title, 50), assignees, estimate)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runSprintRollover(cmd *cobra.Command, args []string) error {
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+
+	var from *project.Iteration
+	if len(args) > 0 {
+		from, err = command.field.Resolve(args[0], command.now)
+	} else {
+		from, err = endingIteration(command.field, command.now)
+	}
+	if err != nil {
+		return err
+	}
+
+	var to *project.Iteration
+	if sprintTo == "@next" {
+		to = command.field.Next(*from)
+		if to == nil {
+			return fmt.Errorf("'%s' has no iteration after %s; add one in the project settings or use --to", command.field.Name, from.Title)
+		}
+	} else {
+		to, err = command.field.Resolve(sprintTo, command.now)
+		if err != nil {
+			return err
+		}
+	}
+	if to.ID == from.ID {
+		return fmt.Errorf("cannot roll %s over into itself", from.Title)
+	}
+
+	var unfinished []filter.ProjectIssue
+	for _, item := range command.itemsIn(*from) {
+		if !isItemFinished(command.config, item) {
+			unfinished = append(unfinished, item)
+		}
+	}
+
+	var moved, failed []int
+	for _, item := range unfinished {
+		if sprintDryRun {
+			moved = append(moved, item.Number)
+			continue
+		}
+		value := map[string]interface{}{"iterationId": to.ID}
+		if err := command.issueAPI.UpdateProjectItemFieldValue(command.projectID, item.ItemID, command.field.ID, value); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to move #%d: %v\n", item.Number, err)
+			failed = append(failed, item.Number)
+			continue
+		}
+		moved = append(moved, item.Number)
+
+		body := issue.RolloverComment(from.ID, from.Title, to.ID, to.Title)
+		if err := command.issueAPI.AddCommentWithRepo(item.Number, repoFromIssueURL(item.URL), body); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to record the rollover of #%d for velocity: %v\n", item.Number, err)
+		}
+	}
+
+	if outputFormat == "json" {
+		if err := output.NewFormatter(output.FormatJSON).Format(map[string]interface{}{
+			"dry_run": sprintDryRun,
+			"from":    from.Title,
+			"to":      to.Title,
+			"moved":   moved,
+			"failed":  failed,
+		}); err != nil {
+			return err
+		}
+	} else {
+		verb := "Moved"
+		if sprintDryRun {
+			verb = "Would move"
+		}
+		if len(unfinished) == 0 {
+			fmt.Printf("%s has no unfinished items\n", from.Title)
+		}
+		for _, item := range unfinished {
+			if !containsNumber(failed, item.Number) {
+				fmt.Printf("✓ %s #%d %s → %s\n", verb, item.Number, item.Title, to.Title)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to move %d of %d items", len(failed), len(unfinished))
+	}
+	return nil
+}
+
+// endingIteration returns the iteration to roll over by default: the current
+// one on its last day, otherwise the last one that has ended
+func endingIteration(field *project.IterationField, now time.Time) (*project.Iteration, error) {
+	if current := field.Current(now); current != nil && !current.End().After(now.AddDate(0, 0, 1)) {
+		return current, nil
+	}
+	ended := completedIterations(field, now, 1)
+	if len(ended) == 0 {
+		return nil, fmt.Errorf("'%s' has no iteration that has ended; name the iteration to roll over", field.Name)
+	}
+	return &ended[0], nil
+}
+
+// containsNumber reports whether number is in numbers
+func containsNumber(numbers []int, number int) bool {
+	for _, n := range numbers {
+		if n == number {
+			return true
+		}
+	}
+	return false
+}
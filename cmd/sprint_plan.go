@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+const unassignedName = "(unassigned)"
+
+var (
+	sprintPlanCapacity    string
+	sprintPlanInteractive bool
+)
+
+var sprintPlanCmd = &cobra.Command{
+	Use:   "plan [iteration]",
+	Short: "Compare an iteration's estimates with the team's capacity",
+	Long: `Sum the estimates of the items in an iteration (the next one by default) per
+assignee and compare them with each person's capacity, flagging anyone who is
+over-allocated.
+
+Capacities come from --capacity or from the sprint section of .gh-pm.yml:
+
+  sprint:
+    capacity:
+      alice: 8
+      bob: 10
+
+With --interactive, open backlog items that are not in any iteration are
+offered one by one, highest priority first, and pulled into the iteration until
+the team's capacity is filled. Items that would put their assignee over
+capacity are not offered, and neither are items without an estimate, whose
+size is unknown. Prompts are written to stderr, so --output json stays clean.`,
+	Example: `  # Check next sprint's allocation
+  gh pm sprint plan --capacity alice=8,bob=10
+
+  # Fill the sprint from the backlog
+  gh pm sprint plan --interactive`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSprintPlan,
+}
+
+func init() {
+	sprintCmd.AddCommand(sprintPlanCmd)
+	sprintPlanCmd.Flags().StringVar(&sprintPlanCapacity, "capacity", "", "Capacity per assignee, e.g. alice=8,bob=10")
+	sprintPlanCmd.Flags().BoolVarP(&sprintPlanInteractive, "interactive", "i", false, "Pull backlog items into the iteration until capacity is filled")
+}
+
+// assigneeLoad is the planned estimate of one assignee
+type assigneeLoad struct {
+	Assignee string  `json:"assignee"`
+	Capacity float64 `json:"capacity"`
+	Planned  float64 `json:"planned"`
+	Items    int     `json:"items"`
+	Over     bool    `json:"over"`
+}
+
+// sprintPlan is the allocation of an iteration
+type sprintPlan struct {
+	Iteration   string         `json:"iteration"`
+	Capacity    float64        `json:"capacity"`
+	Planned     float64        `json:"planned"`
+	Unestimated int            `json:"unestimated"` // Items without an estimate
+	Assignees   []assigneeLoad `json:"assignees"`
+	Pulled      []int          `json:"pulled,omitempty"`
+}
+
+func runSprintPlan(cmd *cobra.Command, args []string) error {
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+
+	name := "@next"
+	if len(args) > 0 {
+		name = args[0]
+	}
+	iteration, err := command.field.Resolve(name, command.now)
+	if err != nil {
+		return err
+	}
+
+	capacity, err := sprintCapacity(command.config, sprintPlanCapacity)
+	if err != nil {
+		return err
+	}
+
+	plan := planSprint(command.config, iteration.Title, command.itemsIn(*iteration), capacity)
+
+	if sprintPlanInteractive {
+		fields, err := loadProjectFields(command.config, command.client, command.projectID)
+		if err != nil {
+			return err
+		}
+		var priorityOrder []string
+		if field := findFieldByName(fields, priorityFieldName(command.config)); field != nil {
+			for _, option := range field.Options {
+				priorityOrder = append(priorityOrder, option.Name)
+			}
+		}
+
+		printSprintPlan(os.Stderr, plan)
+		fmt.Fprintln(os.Stderr)
+
+		puller := &sprintPuller{command: command, iteration: iteration, reader: bufio.NewReader(os.Stdin), out: os.Stderr}
+		candidates := backlogCandidates(command.config, command.items, command.field.Name, priorityOrder)
+		if err := puller.pull(&plan, candidates); err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stderr)
+	}
+
+	if outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(plan)
+	}
+	printSprintPlan(os.Stdout, plan)
+	return nil
+}
+
+// sprintCapacity parses --capacity, falling back to the configured capacities
+func sprintCapacity(cfg *config.Config, flag string) (map[string]float64, error) {
+	if flag == "" {
+		if cfg.Sprint != nil && len(cfg.Sprint.Capacity) > 0 {
+			return cfg.Sprint.Capacity, nil
+		}
+		return nil, fmt.Errorf("no capacity given; use --capacity alice=8,bob=10 or add sprint.capacity to %s", config.ConfigFileName)
+	}
+
+	capacity := make(map[string]float64)
+	for _, entry := range strings.Split(flag, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid capacity '%s': expected name=number", entry)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("invalid capacity '%s': expected name=number", entry)
+		}
+		capacity[strings.TrimPrefix(strings.TrimSpace(parts[0]), "@")] = value
+	}
+	if len(capacity) == 0 {
+		return nil, fmt.Errorf("--capacity is empty")
+	}
+	return capacity, nil
+}
+
+// planSprint sums the estimates per assignee. Items with several assignees
+// are split evenly between them.
+func planSprint(cfg *config.Config, title string, items []filter.ProjectIssue, capacity map[string]float64) sprintPlan {
+	plan := sprintPlan{Iteration: title}
+	loads := make(map[string]*assigneeLoad)
+	load := func(name string) *assigneeLoad {
+		for key, l := range loads {
+			if strings.EqualFold(key, name) {
+				return l
+			}
+		}
+		l := &assigneeLoad{Assignee: name}
+		loads[name] = l
+		return l
+	}
+
+	for name, value := range capacity {
+		load(name).Capacity = value
+		plan.Capacity += value
+	}
+	for _, item := range items {
+		plan.addItem(cfg, item, load)
+	}
+
+	for _, l := range loads {
+		plan.Assignees = append(plan.Assignees, *l)
+	}
+	plan.flagOverAllocation()
+	sort.Slice(plan.Assignees, func(i, j int) bool {
+		a, b := plan.Assignees[i], plan.Assignees[j]
+		if (a.Assignee == unassignedName) != (b.Assignee == unassignedName) {
+			return b.Assignee == unassignedName
+		}
+		return strings.ToLower(a.Assignee) < strings.ToLower(b.Assignee)
+	})
+	return plan
+}
+
+// addItem adds the item's estimate to its assignees
+func (p *sprintPlan) addItem(cfg *config.Config, item filter.ProjectIssue, load func(string) *assigneeLoad) {
+	estimate := 0.0
+	if value := itemEstimate(cfg, item); value != nil {
+		estimate = *value
+	} else {
+		p.Unestimated++
+	}
+	p.Planned += estimate
+
+	assignees := item.Assignees
+	if len(assignees) == 0 {
+		assignees = []string{unassignedName}
+	}
+	for _, assignee := range assignees {
+		l := load(assignee)
+		l.Items++
+		l.Planned += estimate / float64(len(assignees))
+	}
+}
+
+// fits reports whether the item can be added without putting any of its
+// assignees over capacity, or the team over its total capacity. Items without
+// an estimate never fit, since their size is unknown.
+func (p *sprintPlan) fits(cfg *config.Config, item filter.ProjectIssue) bool {
+	value := itemEstimate(cfg, item)
+	if value == nil {
+		return false
+	}
+	estimate := *value
+	if p.Planned+estimate > p.Capacity {
+		return false
+	}
+	for _, assignee := range item.Assignees {
+		// People without a capacity have none to spare
+		planned, capacity := 0.0, 0.0
+		for _, l := range p.Assignees {
+			if strings.EqualFold(l.Assignee, assignee) {
+				planned, capacity = l.Planned, l.Capacity
+			}
+		}
+		if planned+estimate/float64(len(item.Assignees)) > capacity {
+			return false
+		}
+	}
+	return true
+}
+
+// add records a pulled item in the plan
+func (p *sprintPlan) add(cfg *config.Config, item filter.ProjectIssue) {
+	p.addItem(cfg, item, func(name string) *assigneeLoad {
+		for i := range p.Assignees {
+			if strings.EqualFold(p.Assignees[i].Assignee, name) {
+				return &p.Assignees[i]
+			}
+		}
+		p.Assignees = append(p.Assignees, assigneeLoad{Assignee: name})
+		return &p.Assignees[len(p.Assignees)-1]
+	})
+	p.flagOverAllocation()
+	p.Pulled = append(p.Pulled, item.Number)
+}
+
+// flagOverAllocation marks the assignees planned beyond their capacity;
+// unassigned work has no capacity of its own
+func (p *sprintPlan) flagOverAllocation() {
+	for i := range p.Assignees {
+		l := &p.Assignees[i]
+		l.Over = l.Assignee != unassignedName && l.Planned > l.Capacity
+	}
+}
+
+// backlogCandidates returns the open, unfinished items without an iteration,
+// ordered by priority option order and then by number
+func backlogCandidates(cfg *config.Config, items []filter.ProjectIssue, iterationField string, priorityOrder []string) []filter.ProjectIssue {
+	rank := func(item filter.ProjectIssue) int {
+		priority, _ := item.Fields[priorityFieldName(cfg)].(string)
+		for i, name := range priorityOrder {
+			if strings.EqualFold(name, priority) {
+				return i
+			}
+		}
+		return len(priorityOrder)
+	}
+
+	var candidates []filter.ProjectIssue
+	for _, item := range items {
+		if title, ok := item.Fields[iterationField].(string); ok && title != "" {
+			continue
+		}
+		if isItemFinished(cfg, item) {
+			continue
+		}
+		candidates = append(candidates, item)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ri, rj := rank(candidates[i]), rank(candidates[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return candidates[i].Number < candidates[j].Number
+	})
+	return candidates
+}
+
+// sprintPuller asks which backlog items to pull into the iteration
+type sprintPuller struct {
+	command   *SprintCommand
+	iteration *project.Iteration
+	reader    *bufio.Reader
+	out       io.Writer
+}
+
+// pull offers the candidates that fit until the capacity is filled or the
+// user quits, moving accepted items into the iteration
+func (p *sprintPuller) pull(plan *sprintPlan, candidates []filter.ProjectIssue) error {
+	cfg := p.command.config
+	unestimated := 0
+	defer func() {
+		if unestimated > 0 {
+			fmt.Fprintf(p.out, "%d backlog items without an estimate were not offered\n", unestimated)
+		}
+	}()
+
+	for _, item := range candidates {
+		if plan.Planned >= plan.Capacity {
+			fmt.Fprintf(p.out, "Capacity of %s is filled\n", formatNumber(plan.Capacity))
+			return nil
+		}
+		if itemEstimate(cfg, item) == nil {
+			unestimated++
+			continue
+		}
+		if !plan.fits(cfg, item) {
+			continue
+		}
+
+		details := []string{}
+		if priority, ok := item.Fields[priorityFieldName(cfg)].(string); ok && priority != "" {
+			details = append(details, priority)
+		}
+		if estimate := itemEstimate(cfg, item); estimate != nil {
+			details = append(details, "estimate "+formatNumber(*estimate))
+		}
+		for _, assignee := range item.Assignees {
+			details = append(details, "@"+assignee)
+		}
+		fmt.Fprintf(p.out, "Pull #%d %s [%s] into %s? (%s of %s planned) [y/N/q]: ",
+			item.Number, item.Title, strings.Join(details, ", "), p.iteration.Title,
+			formatNumber(plan.Planned), formatNumber(plan.Capacity))
+
+		input, err := p.reader.ReadString('\n')
+		answer := strings.ToLower(strings.TrimSpace(input))
+		if answer == "q" || answer == "quit" || (err != nil && answer == "") {
+			return nil
+		}
+		if answer != "y" && answer != "yes" {
+			continue
+		}
+
+		value := map[string]interface{}{"iterationId": p.iteration.ID}
+		if err := p.command.issueAPI.UpdateProjectItemFieldValue(p.command.projectID, item.ItemID, p.command.field.ID, value); err != nil {
+			return fmt.Errorf("failed to move #%d into %s: %w", item.Number, p.iteration.Title, err)
+		}
+		plan.add(cfg, item)
+		fmt.Fprintf(p.out, "  ✓ Added #%d\n", item.Number)
+	}
+	return nil
+}
+
+// printSprintPlan prints the allocation per assignee
+func printSprintPlan(out io.Writer, plan sprintPlan) {
+	fmt.Fprintf(out, "%s: %s of %s planned", plan.Iteration, formatNumber(plan.Planned), formatNumber(plan.Capacity))
+	if plan.Unestimated > 0 {
+		fmt.Fprintf(out, " (%d items without an estimate)", plan.Unestimated)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ASSIGNEE\tITEMS\tPLANNED\tCAPACITY\tREMAINING\n")
+	for _, l := range plan.Assignees {
+		remaining := formatNumber(l.Capacity - l.Planned)
+		if l.Assignee == unassignedName {
+			remaining = "-"
+		} else if l.Over {
+			remaining = fmt.Sprintf("⚠ over by %s", formatNumber(l.Planned-l.Capacity))
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", l.Assignee, l.Items, formatNumber(l.Planned), formatNumber(l.Capacity), remaining)
+	}
+	w.Flush()
+}
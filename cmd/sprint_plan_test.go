@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func TestSprintCapacity(t *testing.T) {
+	capacity, err := sprintCapacity(&config.Config{}, "alice=8, @bob=10.5")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"alice": 8, "bob": 10.5}, capacity)
+
+	cfg := &config.Config{Sprint: &config.SprintConfig{Capacity: map[string]float64{"carol": 5}}}
+	capacity, err = sprintCapacity(cfg, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"carol": 5}, capacity)
+
+	for _, invalid := range []string{"alice", "alice=many", "=3", "bob=-1", ","} {
+		_, err := sprintCapacity(cfg, invalid)
+		assert.Error(t, err, invalid)
+	}
+	_, err = sprintCapacity(&config.Config{}, "")
+	assert.Error(t, err)
+}
+
+func TestPlanSprint(t *testing.T) {
+	cfg := &config.Config{}
+	items := []filter.ProjectIssue{
+		{Number: 1, Assignees: []string{"Alice"}, Fields: map[string]interface{}{"Estimate": 5.0}},
+		{Number: 2, Assignees: []string{"alice", "bob"}, Fields: map[string]interface{}{"Estimate": 4.0}},
+		{Number: 3, Fields: map[string]interface{}{"Estimate": 2.0}},
+		{Number: 4, Assignees: []string{"dave"}, Fields: map[string]interface{}{}},
+	}
+
+	plan := planSprint(cfg, "Sprint 2", items, map[string]float64{"alice": 6, "bob": 10})
+
+	assert.Equal(t, 16.0, plan.Capacity)
+	assert.Equal(t, 11.0, plan.Planned)
+	assert.Equal(t, 1, plan.Unestimated)
+	assert.Equal(t, []assigneeLoad{
+		{Assignee: "alice", Capacity: 6, Planned: 7, Items: 2, Over: true},
+		{Assignee: "bob", Capacity: 10, Planned: 2, Items: 1},
+		{Assignee: "dave", Items: 1},
+		{Assignee: unassignedName, Planned: 2, Items: 1},
+	}, plan.Assignees)
+}
+
+func TestSprintPlanFits(t *testing.T) {
+	cfg := &config.Config{}
+	plan := planSprint(cfg, "Sprint 2", []filter.ProjectIssue{
+		{Number: 1, Assignees: []string{"alice"}, Fields: map[string]interface{}{"Estimate": 5.0}},
+	}, map[string]float64{"alice": 6, "bob": 4})
+
+	estimated := func(value float64, assignees ...string) filter.ProjectIssue {
+		return filter.ProjectIssue{Assignees: assignees, Fields: map[string]interface{}{"Estimate": value}}
+	}
+
+	assert.True(t, plan.fits(cfg, estimated(1, "alice")))
+	assert.False(t, plan.fits(cfg, estimated(2, "alice")), "alice would be over capacity")
+	assert.True(t, plan.fits(cfg, estimated(4, "bob")))
+	assert.True(t, plan.fits(cfg, estimated(3)), "unassigned items only count against the team")
+	assert.False(t, plan.fits(cfg, estimated(6)), "the team would be over capacity")
+	assert.False(t, plan.fits(cfg, estimated(1, "erin")), "erin has no capacity")
+	assert.False(t, plan.fits(cfg, filter.ProjectIssue{Fields: map[string]interface{}{}}), "the size of an unestimated item is unknown")
+
+	plan.add(cfg, estimated(3, "bob"))
+	assert.Equal(t, 8.0, plan.Planned)
+	assert.False(t, plan.fits(cfg, estimated(2, "bob")))
+}
+
+func TestBacklogCandidates(t *testing.T) {
+	cfg := &config.Config{}
+	items := []filter.ProjectIssue{
+		{Number: 1, State: "open", Fields: map[string]interface{}{"Priority": "P2"}},
+		{Number: 2, State: "open", Fields: map[string]interface{}{"Priority": "P0", "Sprint": "Sprint 1"}},
+		{Number: 3, State: "open", Fields: map[string]interface{}{}},
+		{Number: 4, State: "closed", Fields: map[string]interface{}{"Priority": "P0"}},
+		{Number: 5, State: "open", Fields: map[string]interface{}{"Priority": "P0"}},
+		{Number: 6, State: "open", Fields: map[string]interface{}{"Priority": "p2"}},
+		{Number: 7, State: "open", Fields: map[string]interface{}{"Priority": "P1", "Status": "Done"}},
+	}
+
+	var numbers []int
+	for _, item := range backlogCandidates(cfg, items, "Sprint", []string{"P0", "P1", "P2"}) {
+		numbers = append(numbers, item.Number)
+	}
+	assert.Equal(t, []int{5, 1, 6, 3}, numbers)
+}
+
+func TestSprintPullerSkipsUnestimated(t *testing.T) {
+	cfg := &config.Config{}
+	plan := planSprint(cfg, "Sprint 2", nil, map[string]float64{"alice": 6})
+	candidates := []filter.ProjectIssue{
+		{Number: 1, Title: "Unsized", Fields: map[string]interface{}{}},
+		{Number: 2, Title: "Sized", Fields: map[string]interface{}{"Estimate": 2.0}},
+		{Number: 3, Title: "Also unsized", Fields: map[string]interface{}{}},
+	}
+	var out strings.Builder
+	puller := &sprintPuller{
+		command:   &SprintCommand{config: cfg},
+		iteration: &project.Iteration{Title: "Sprint 2"},
+		reader:    bufio.NewReader(strings.NewReader("n\n")),
+		out:       &out,
+	}
+
+	require.NoError(t, puller.pull(&plan, candidates))
+	assert.Equal(t, 1, strings.Count(out.String(), "Pull #"))
+	assert.Contains(t, out.String(), "Pull #2 Sized")
+	assert.Contains(t, out.String(), "2 backlog items without an estimate were not offered")
+}
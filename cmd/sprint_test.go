@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func sprintTestConfig() *config.Config {
+	return &config.Config{Fields: map[string]config.Field{
+		"status":   {Field: "Status", Values: map[string]string{"done": "Shipped"}},
+		"estimate": {Field: "Points"},
+	}}
+}
+
+func TestGroupByStatus(t *testing.T) {
+	cfg := sprintTestConfig()
+	items := []filter.ProjectIssue{
+		{Number: 1, Fields: map[string]interface{}{"Status": "In Progress", "Points": 3.0}},
+		{Number: 2, Fields: map[string]interface{}{"Points": 1.0}},
+		{Number: 3, Fields: map[string]interface{}{"Status": "Todo", "Points": 2.0}},
+		{Number: 4, Fields: map[string]interface{}{"Status": "in progress"}, Assignees: []string{"alice"}},
+		{Number: 5, Fields: map[string]interface{}{"Status": "Blocked", "Points": 5.0}},
+	}
+
+	groups := groupByStatus(cfg, items, []string{"Todo", "In Progress", "Shipped"})
+
+	var statuses []string
+	for _, group := range groups {
+		statuses = append(statuses, group.Status)
+	}
+	assert.Equal(t, []string{"Todo", "In Progress", "Blocked", "(no status)"}, statuses,
+		"option order first, unknown statuses after, empty groups dropped")
+
+	require.Len(t, groups[1].Items, 2)
+	assert.Equal(t, 3.0, groups[1].Estimate)
+	assert.Equal(t, []string{"alice"}, groups[1].Items[1].Assignees)
+	assert.Nil(t, groups[1].Items[1].Estimate)
+}
+
+func TestIsItemFinished(t *testing.T) {
+	cfg := sprintTestConfig()
+	assert.True(t, isItemFinished(cfg, filter.ProjectIssue{State: "closed"}))
+	assert.True(t, isItemFinished(cfg, filter.ProjectIssue{State: "open", Fields: map[string]interface{}{"Status": "shipped"}}))
+	assert.False(t, isItemFinished(cfg, filter.ProjectIssue{State: "open", Fields: map[string]interface{}{"Status": "Done"}}),
+		"done is resolved through the config")
+	assert.False(t, isItemFinished(cfg, filter.ProjectIssue{State: "open", Fields: map[string]interface{}{}}))
+}
+
+func TestIterationState(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.Local)
+	assert.Equal(t, "current", iterationState(project.Iteration{StartDate: "2026-03-16", Duration: 14}, now))
+	assert.Equal(t, "completed", iterationState(project.Iteration{StartDate: "2026-03-02", Duration: 14, Completed: true}, now))
+	assert.Equal(t, "completed", iterationState(project.Iteration{StartDate: "2026-03-02", Duration: 14}, now))
+	assert.Equal(t, "upcoming", iterationState(project.Iteration{StartDate: "2026-03-30", Duration: 14}, now))
+}
+
+func TestSelectIterationField(t *testing.T) {
+	fields := []project.IterationField{{ID: "F1", Name: "Sprint"}, {ID: "F2", Name: "Quarter"}}
+
+	field, err := selectIterationField(fields, "")
+	require.NoError(t, err)
+	assert.Equal(t, "F1", field.ID)
+
+	field, err = selectIterationField(fields, "quarter")
+	require.NoError(t, err)
+	assert.Equal(t, "F2", field.ID)
+
+	_, err = selectIterationField(fields, "Cycle")
+	assert.Error(t, err)
+
+	_, err = selectIterationField(nil, "")
+	assert.Error(t, err)
+}
+
+func TestEndingIteration(t *testing.T) {
+	field := &project.IterationField{Name: "Sprint", Iterations: []project.Iteration{
+		{ID: "IT_1", Title: "Sprint 1", StartDate: "2026-03-02", Duration: 14, Completed: true},
+		{ID: "IT_2", Title: "Sprint 2", StartDate: "2026-03-16", Duration: 14},
+	}}
+
+	iteration, err := endingIteration(field, time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local))
+	require.NoError(t, err)
+	assert.Equal(t, "IT_1", iteration.ID, "after a sprint ends, the sprint that ended")
+
+	iteration, err = endingIteration(field, time.Date(2026, 3, 29, 17, 0, 0, 0, time.Local))
+	require.NoError(t, err)
+	assert.Equal(t, "IT_2", iteration.ID, "the current sprint on its last day")
+
+	_, err = endingIteration(&project.IterationField{Name: "Sprint", Iterations: field.Iterations[1:]}, time.Date(2026, 3, 17, 9, 0, 0, 0, time.Local))
+	assert.Error(t, err)
+}
+
+func TestItemsInMatchesIterationID(t *testing.T) {
+	command := &SprintCommand{
+		field: &project.IterationField{Name: "Sprint"},
+		items: []filter.ProjectIssue{
+			{Number: 1, Fields: map[string]interface{}{"Sprint": "Sprint 1"}, IterationIDs: map[string]string{"Sprint": "IT_1"}},
+			{Number: 2, Fields: map[string]interface{}{"Sprint": "Sprint 1"}, IterationIDs: map[string]string{"Sprint": "IT_9"}},
+			{Number: 3},
+		},
+	}
+
+	items := command.itemsIn(project.Iteration{ID: "IT_1", Title: "Sprint 1"})
+	require.Len(t, items, 1, "iterations with the same title are told apart by ID")
+	assert.Equal(t, 1, items[0].Number)
+}
@@ -0,0 +1,533 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+	"github.com/yahsan2/gh-pm/pkg/utils"
+)
+
+const (
+	defaultStaleLabel   = "stale"
+	defaultStaleComment = "This item has been in {status} for {days} days. Is it still on track?"
+	// staleCommentMarker is appended to nudge comments so later runs can find them
+	staleCommentMarker = "<!-- gh-pm stale -->"
+)
+
+var (
+	staleStatus    string
+	staleOlderThan string
+	staleActions   []string
+	staleLabel     string
+	staleComment   string
+	staleTo        string
+	staleDryRun    bool
+	staleForce     bool
+	staleLimit     int
+)
+
+// staleCmd lists items that have stayed in a status for too long
+var staleCmd = &cobra.Command{
+	Use:   "stale",
+	Short: "List items that have sat in a status too long",
+	Long: `List the project items that have stayed in a status longer than a threshold.
+
+An item's age is the time since it last entered its current status, from the
+project status-change events, or since it was last updated when there is no
+such event. Statuses are given as keys of fields.status in .gh-pm.yml, such as
+in_progress. Without --status, every status in stale.thresholds is checked
+against its own threshold; --older-than overrides the thresholds.
+
+With --action, stale items can be nudged:
+  label    Add a label (--label, stale.label, default "stale")
+  comment  Post a comment (--comment, stale.comment); {number}, {title},
+           {status}, {days} and {assignees} are replaced
+  move     Move to a status (--to, stale.move_to), following the workflow
+           rules in .gh-pm.yml like 'gh pm move'; --force overrides them
+
+Nudges are not repeated: items that already carry the label are not labelled
+again, and items that got a nudge comment within the threshold are not
+commented on again. With --output json, each item lists the nudges applied,
+skipped or failed.`,
+	Example: `  # Items in progress for more than a week
+  gh pm stale --status in_progress --older-than 7d
+
+  # Every status configured under stale.thresholds
+  gh pm stale
+
+  # Label and ping the assignees of items stuck in review
+  gh pm stale --status in_review --older-than 3d --action label,comment
+
+  # Send abandoned work back to the backlog, previewing first
+  gh pm stale --status in_progress --older-than 2w --action move --to backlog --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runStale,
+}
+
+func init() {
+	rootCmd.AddCommand(staleCmd)
+	staleCmd.Flags().StringVar(&staleStatus, "status", "", "Status key to check, e.g. in_progress (default: the statuses in stale.thresholds)")
+	staleCmd.Flags().StringVar(&staleOlderThan, "older-than", "", "Minimum age, e.g. 7d, 2w or 12h (default: stale.thresholds)")
+	staleCmd.Flags().StringSliceVar(&staleActions, "action", nil, "Nudge stale items: label, comment and/or move")
+	staleCmd.Flags().StringVar(&staleLabel, "label", "", "Label to add with --action label")
+	staleCmd.Flags().StringVar(&staleComment, "comment", "", "Comment template for --action comment")
+	staleCmd.Flags().StringVar(&staleTo, "to", "", "Status key to move to with --action move")
+	staleCmd.Flags().BoolVar(&staleDryRun, "dry-run", false, "Show the actions without applying them")
+	staleCmd.Flags().BoolVar(&staleForce, "force", false, "Move even when it breaks the workflow rules in .gh-pm.yml")
+	staleCmd.Flags().IntVarP(&staleLimit, "limit", "L", 500, "Maximum number of project items to read")
+}
+
+// staleCheck is a status and how long items may stay in it
+type staleCheck struct {
+	Key       string
+	Status    string // Project option name
+	Threshold time.Duration
+}
+
+// staleItem is an item that has stayed in its status past the threshold
+type staleItem struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Status    string    `json:"status"`
+	Since     time.Time `json:"since"`
+	Source    string    `json:"source"` // status_change or updated_at
+	Days      int       `json:"days"`
+	Assignees []string  `json:"assignees,omitempty"`
+	// Set by --action: the nudges applied (or planned in a dry run), skipped
+	// or failed, and workflow rules a dry-run move would break
+	Actions   []string `json:"actions,omitempty"`
+	Skipped   []string `json:"skipped,omitempty"`
+	Warnings  []string `json:"warnings,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+	itemID    string
+	labels    []string
+	threshold time.Duration
+	item      filter.ProjectIssue
+}
+
+func runStale(cmd *cobra.Command, args []string) error {
+	actions, err := parseStaleActions(staleActions)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+
+	checks, err := staleChecks(cfg, staleStatus, staleOlderThan)
+	if err != nil {
+		return err
+	}
+
+	projectClient, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, projectClient)
+	if err != nil {
+		return err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, staleLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	issueClient := issue.NewClient()
+	statusField := statusFieldName(cfg)
+	now := time.Now()
+	histories := fetchHistories(issueClient, items, func(item filter.ProjectIssue) bool {
+		status, _ := item.Fields[statusField].(string)
+		return matchStaleCheck(checks, status) != nil
+	})
+	stale := []staleItem{}
+	for i, item := range items {
+		status, _ := item.Fields[statusField].(string)
+		check := matchStaleCheck(checks, status)
+		if check == nil {
+			continue
+		}
+		if entry := staleEntry(item, status, histories[i], projectID, *check, now); entry != nil {
+			stale = append(stale, *entry)
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool { return stale[i].Since.Before(stale[j].Since) })
+
+	if outputFormat != "json" {
+		if err := printStaleItems(stale); err != nil {
+			return err
+		}
+	}
+	if len(actions) == 0 || len(stale) == 0 {
+		if outputFormat == "json" {
+			return output.NewFormatter(output.FormatJSON).Format(stale)
+		}
+		return nil
+	}
+
+	nudger := &staleNudger{
+		issueClient: issueClient,
+		projectID:   projectID,
+		now:         now,
+		actions:     actions,
+		label:       firstNonEmpty(staleLabel, staleConfigValue(cfg, func(s *config.StaleConfig) string { return s.Label }), defaultStaleLabel),
+		comment:     firstNonEmpty(staleComment, staleConfigValue(cfg, func(s *config.StaleConfig) string { return s.Comment }), defaultStaleComment),
+	}
+	if actions["move"] {
+		moveTo := firstNonEmpty(staleTo, staleConfigValue(cfg, func(s *config.StaleConfig) string { return s.MoveTo }))
+		if moveTo == "" {
+			return fmt.Errorf("--action move needs --to or stale.move_to in %s", config.ConfigFileName)
+		}
+		nudger.moveTo = statusOptionName(cfg, moveTo, moveTo)
+		// Keep stdout for the JSON report
+		guardOut := os.Stdout
+		if outputFormat == "json" {
+			guardOut = os.Stderr
+		}
+		nudger.guard = newWorkflowGuard(cfg, staleForce, guardOut, func() ([]filter.ProjectIssue, error) {
+			// WIP limits count every item, not only the first --limit
+			if len(items) < staleLimit {
+				return items, nil
+			}
+			return searchClient.FetchProjectIssues(projectID, 0)
+		})
+		if !staleDryRun {
+			fields, err := projectClient.GetFieldsWithOptions(projectID)
+			if err != nil {
+				return fmt.Errorf("failed to get project fields: %w", err)
+			}
+			nudger.statusField = findFieldByName(fields, statusField)
+			if nudger.statusField == nil {
+				return fmt.Errorf("field '%s' not found in project", statusField)
+			}
+		}
+	}
+	nudgeErr := nudger.nudge(stale)
+	if outputFormat == "json" {
+		if err := output.NewFormatter(output.FormatJSON).Format(stale); err != nil {
+			return err
+		}
+	} else {
+		printStaleNudges(stale)
+	}
+	return nudgeErr
+}
+
+// parseStaleActions validates the --action values
+func parseStaleActions(values []string) (map[string]bool, error) {
+	actions := map[string]bool{}
+	for _, value := range values {
+		action := strings.ToLower(strings.TrimSpace(value))
+		switch action {
+		case "label", "comment", "move":
+			actions[action] = true
+		default:
+			return nil, fmt.Errorf("invalid action: %s (expected label, comment or move)", value)
+		}
+	}
+	return actions, nil
+}
+
+// staleChecks resolves the statuses to check and their thresholds from the
+// flags and stale.thresholds
+func staleChecks(cfg *config.Config, statusKey, olderThan string) ([]staleCheck, error) {
+	var thresholds map[string]string
+	if cfg.Stale != nil {
+		thresholds = cfg.Stale.Thresholds
+	}
+
+	var keys []string
+	switch {
+	case statusKey != "":
+		keys = []string{statusKey}
+	case len(thresholds) > 0:
+		for key := range thresholds {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	default:
+		return nil, fmt.Errorf("no status to check: use --status or set stale.thresholds in %s", config.ConfigFileName)
+	}
+
+	var checks []staleCheck
+	for _, key := range keys {
+		age := olderThan
+		if age == "" {
+			age = thresholds[key]
+		}
+		if age == "" {
+			return nil, fmt.Errorf("no threshold for status '%s': use --older-than or set stale.thresholds.%s", key, key)
+		}
+		threshold, err := utils.ParseAge(age)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, staleCheck{Key: key, Status: statusOptionName(cfg, key, key), Threshold: threshold})
+	}
+	return checks, nil
+}
+
+// matchStaleCheck returns the check for an item's status, or nil
+func matchStaleCheck(checks []staleCheck, status string) *staleCheck {
+	if status == "" {
+		return nil
+	}
+	for i := range checks {
+		if strings.EqualFold(checks[i].Status, status) {
+			return &checks[i]
+		}
+	}
+	return nil
+}
+
+// staleEntry returns the item when it entered its status, or was last updated,
+// longer ago than the check's threshold
+func staleEntry(item filter.ProjectIssue, status string, history []issue.HistoryEvent, projectID string, check staleCheck, now time.Time) *staleItem {
+	var since time.Time
+	source := "status_change"
+	if changed := lastStatusChange(history, projectID, status); changed != nil {
+		since = *changed
+	} else if updated, err := time.Parse(time.RFC3339, item.UpdatedAt); err == nil {
+		since = updated
+		source = "updated_at"
+	} else {
+		return nil
+	}
+
+	age := now.Sub(since)
+	if age < check.Threshold {
+		return nil
+	}
+	return &staleItem{
+		Number:    item.Number,
+		Title:     item.Title,
+		URL:       item.URL,
+		Status:    status,
+		Since:     since,
+		Source:    source,
+		Days:      int(age.Hours() / 24),
+		Assignees: item.Assignees,
+		itemID:    item.ItemID,
+		labels:    item.Labels,
+		threshold: check.Threshold,
+		item:      item,
+	}
+}
+
+// renderStaleComment fills in the placeholders of a comment template
+func renderStaleComment(template string, item staleItem) string {
+	var mentions []string
+	for _, assignee := range item.Assignees {
+		mentions = append(mentions, "@"+assignee)
+	}
+	return strings.NewReplacer(
+		"{number}", strconv.Itoa(item.Number),
+		"{title}", item.Title,
+		"{status}", item.Status,
+		"{days}", strconv.Itoa(item.Days),
+		"{assignees}", strings.Join(mentions, " "),
+	).Replace(template)
+}
+
+func printStaleItems(items []staleItem) error {
+	if len(items) == 0 {
+		fmt.Println("No stale items")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NUMBER\tTITLE\tSTATUS\tAGE\tSINCE\tASSIGNEES\n")
+	for _, item := range items {
+		since := item.Since.Local().Format("2006-01-02")
+		if item.Source == "updated_at" {
+			since += " (updated)"
+		}
+		fmt.Fprintf(w, "#%d\t%s\t%s\t%dd\t%s\t%s\n", item.Number, truncate(item.Title, 50), item.Status,
+			item.Days, since, strings.Join(item.Assignees, ", "))
+	}
+	return w.Flush()
+}
+
+// staleIssueAPI is the part of the issue client the nudges use
+type staleIssueAPI interface {
+	AddLabelsWithRepo(number int, repo string, labels []string) error
+	ListCommentsWithRepo(number int, repo string) ([]issue.Comment, error)
+	AddCommentWithRepo(number int, repo, body string) error
+	UpdateProjectItemField(projectID, itemID, fieldID, optionID string) error
+}
+
+// staleNudger applies the --action nudges to stale items
+type staleNudger struct {
+	issueClient staleIssueAPI
+	projectID   string
+	now         time.Time
+	actions     map[string]bool
+	label       string
+	comment     string
+	moveTo      string
+	statusField *project.Field
+	guard       *workflowGuard
+}
+
+// nudge applies the actions to the items, recording on each item what was
+// done, skipped or failed
+func (n *staleNudger) nudge(items []staleItem) error {
+	failed := 0
+	for i := range items {
+		item := &items[i]
+		repo := repoFromIssueURL(item.URL)
+		if n.actions["label"] {
+			if containsFold(item.labels, n.label) {
+				item.Skipped = append(item.Skipped, "already labelled "+n.label)
+			} else if err := n.run(func() error { return n.issueClient.AddLabelsWithRepo(item.Number, repo, []string{n.label}) }); err != nil {
+				item.Errors = append(item.Errors, err.Error())
+			} else {
+				item.Actions = append(item.Actions, "add label "+n.label)
+			}
+		}
+		if n.actions["comment"] {
+			nudged, err := n.recentlyNudged(*item, repo)
+			switch {
+			case err != nil:
+				item.Errors = append(item.Errors, err.Error())
+			case nudged:
+				item.Skipped = append(item.Skipped, "already nudged")
+			default:
+				body := renderStaleComment(n.comment, *item) + "\n\n" + staleCommentMarker
+				if err := n.run(func() error { return n.issueClient.AddCommentWithRepo(item.Number, repo, body) }); err != nil {
+					item.Errors = append(item.Errors, err.Error())
+				} else {
+					item.Actions = append(item.Actions, "comment")
+				}
+			}
+		}
+		if n.actions["move"] && !strings.EqualFold(item.Status, n.moveTo) {
+			err := n.guard.check(item.Number, item.Status, n.moveTo)
+			if err == nil {
+				err = n.guard.checkRequirements(item.Number, n.moveTo, item.item)
+			}
+			if err != nil && staleDryRun {
+				item.Warnings = append(item.Warnings, err.Error())
+				err = nil
+			}
+			if err == nil {
+				err = n.run(func() error { return n.move(*item) })
+			}
+			if err != nil {
+				item.Errors = append(item.Errors, err.Error())
+			} else {
+				item.Actions = append(item.Actions, "move to "+n.moveTo)
+				if !staleDryRun {
+					n.guard.record(item.Status, n.moveTo)
+				}
+			}
+		}
+		if len(item.Errors) > 0 {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to nudge %d of %d items", failed, len(items))
+	}
+	return nil
+}
+
+// printStaleNudges prints what nudge did to each item
+func printStaleNudges(items []staleItem) {
+	fmt.Println()
+	prefix := "✓"
+	if staleDryRun {
+		prefix = "🔍 DRY-RUN:"
+	}
+	for _, item := range items {
+		for _, warning := range item.Warnings {
+			fmt.Printf("⚠ %s\n", warning)
+		}
+		if len(item.Actions) > 0 {
+			fmt.Printf("%s #%d %s: %s\n", prefix, item.Number, item.Title, strings.Join(item.Actions, ", "))
+		}
+		if len(item.Skipped) > 0 {
+			fmt.Printf("  - #%d %s (%s, skipped)\n", item.Number, item.Title, strings.Join(item.Skipped, ", "))
+		}
+		if len(item.Errors) > 0 {
+			fmt.Printf("✗ #%d %s: %s\n", item.Number, item.Title, strings.Join(item.Errors, "; "))
+		}
+	}
+}
+
+// run applies an action unless this is a dry run
+func (n *staleNudger) run(action func() error) error {
+	if staleDryRun {
+		return nil
+	}
+	return action()
+}
+
+// recentlyNudged reports whether the item got a nudge comment within its threshold
+func (n *staleNudger) recentlyNudged(item staleItem, repo string) (bool, error) {
+	comments, err := n.issueClient.ListCommentsWithRepo(item.Number, repo)
+	if err != nil {
+		return false, err
+	}
+	since := n.now.Add(-item.threshold)
+	for _, comment := range comments {
+		if strings.Contains(comment.Body, staleCommentMarker) && comment.CreatedAt.After(since) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// move sets the item's status to the move target
+func (n *staleNudger) move(item staleItem) error {
+	if item.itemID == "" {
+		return fmt.Errorf("project item not found")
+	}
+	for _, option := range n.statusField.Options {
+		if strings.EqualFold(option.Name, n.moveTo) {
+			return n.issueClient.UpdateProjectItemField(n.projectID, item.itemID, n.statusField.ID, option.ID)
+		}
+	}
+	return fmt.Errorf("option '%s' not found for field '%s'", n.moveTo, n.statusField.Name)
+}
+
+// staleConfigValue reads a stale setting, or "" when stale is not configured
+func staleConfigValue(cfg *config.Config, get func(*config.StaleConfig) string) string {
+	if cfg.Stale == nil {
+		return ""
+	}
+	return get(cfg.Stale)
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func TestStaleChecks(t *testing.T) {
+	cfg := &config.Config{
+		Fields: map[string]config.Field{
+			"status": {Field: "Status", Values: map[string]string{"in_progress": "In Progress", "in_review": "Review"}},
+		},
+		Stale: &config.StaleConfig{Thresholds: map[string]string{"in_review": "3d", "in_progress": "1w"}},
+	}
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		status    string
+		olderThan string
+		want      []staleCheck
+		wantErr   string
+	}{
+		{
+			name: "configured thresholds",
+			want: []staleCheck{
+				{Key: "in_progress", Status: "In Progress", Threshold: 7 * day},
+				{Key: "in_review", Status: "Review", Threshold: 3 * day},
+			},
+		},
+		{
+			name:   "one status with its configured threshold",
+			status: "in_review",
+			want:   []staleCheck{{Key: "in_review", Status: "Review", Threshold: 3 * day}},
+		},
+		{
+			name:      "flag overrides the threshold",
+			status:    "in_progress",
+			olderThan: "2d",
+			want:      []staleCheck{{Key: "in_progress", Status: "In Progress", Threshold: 2 * day}},
+		},
+		{
+			name:      "unmapped status is used as the option name",
+			status:    "Blocked",
+			olderThan: "12h",
+			want:      []staleCheck{{Key: "Blocked", Status: "Blocked", Threshold: 12 * time.Hour}},
+		},
+		{
+			name:    "status without threshold",
+			status:  "Blocked",
+			wantErr: "no threshold for status 'Blocked'",
+		},
+		{
+			name:      "invalid age",
+			status:    "in_progress",
+			olderThan: "soon",
+			wantErr:   "invalid age",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks, err := staleChecks(cfg, tt.status, tt.olderThan)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, checks)
+		})
+	}
+
+	_, err := staleChecks(&config.Config{}, "", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no status to check")
+}
+
+func TestStaleEntry(t *testing.T) {
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	check := staleCheck{Key: "in_progress", Status: "In Progress", Threshold: 7 * 24 * time.Hour}
+	item := filter.ProjectIssue{Number: 4, Title: "Rate limiting", ItemID: "PVTI_4", UpdatedAt: now.AddDate(0, 0, -1).Format(time.RFC3339)}
+
+	history := []issue.HistoryEvent{
+		statusEvent(now.AddDate(0, 0, -20), "P_1", "Todo", "In Progress"),
+		statusEvent(now.AddDate(0, 0, -15), "P_1", "In Progress", "Review"),
+		statusEvent(now.AddDate(0, 0, -9), "P_1", "Review", "In Progress"),
+	}
+	entry := staleEntry(item, "In Progress", history, "P_1", check, now)
+	require.NotNil(t, entry)
+	assert.Equal(t, 9, entry.Days, "measured from the last time it entered the status")
+	assert.Equal(t, "status_change", entry.Source)
+	assert.Equal(t, "PVTI_4", entry.itemID)
+
+	entry = staleEntry(item, "In Progress", history[:2], "P_2", check, now)
+	assert.Nil(t, entry, "falls back to updatedAt, which is recent")
+
+	item.UpdatedAt = now.AddDate(0, 0, -8).Format(time.RFC3339)
+	entry = staleEntry(item, "In Progress", nil, "P_1", check, now)
+	require.NotNil(t, entry)
+	assert.Equal(t, "updated_at", entry.Source)
+	assert.Equal(t, 8, entry.Days)
+}
+
+func TestRenderStaleComment(t *testing.T) {
+	item := staleItem{Number: 4, Title: "Rate limiting", Status: "In Progress", Days: 9, Assignees: []string{"alice", "bob"}}
+	assert.Equal(t, "This item has been in In Progress for 9 days. Is it still on track?", renderStaleComment(defaultStaleComment, item))
+	assert.Equal(t, "@alice @bob: #4 Rate limiting needs an update", renderStaleComment("{assignees}: #{number} {title} needs an update", item))
+}
+
+func TestParseStaleActions(t *testing.T) {
+	actions, err := parseStaleActions([]string{"Label", "comment"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"label": true, "comment": true}, actions)
+
+	_, err = parseStaleActions([]string{"close"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid action: close")
+}
+
+// fakeStaleAPI records the nudges and serves comments per issue number
+type fakeStaleAPI struct {
+	comments map[int][]issue.Comment
+	labelled []int
+	posted   []int
+	moved    []string
+}
+
+func (f *fakeStaleAPI) AddLabelsWithRepo(number int, repo string, labels []string) error {
+	f.labelled = append(f.labelled, number)
+	return nil
+}
+
+func (f *fakeStaleAPI) ListCommentsWithRepo(number int, repo string) ([]issue.Comment, error) {
+	return f.comments[number], nil
+}
+
+func (f *fakeStaleAPI) AddCommentWithRepo(number int, repo, body string) error {
+	f.posted = append(f.posted, number)
+	if f.comments == nil {
+		f.comments = map[int][]issue.Comment{}
+	}
+	f.comments[number] = append(f.comments[number], issue.Comment{Body: body, CreatedAt: time.Now()})
+	return nil
+}
+
+func (f *fakeStaleAPI) UpdateProjectItemField(projectID, itemID, fieldID, optionID string) error {
+	f.moved = append(f.moved, itemID)
+	return nil
+}
+
+func TestStaleNudgerIsIdempotent(t *testing.T) {
+	now := time.Now()
+	week := 7 * 24 * time.Hour
+	api := &fakeStaleAPI{comments: map[int][]issue.Comment{
+		2: {{Body: "Still going?\n\n" + staleCommentMarker, CreatedAt: now.AddDate(0, 0, -3)}},
+		3: {{Body: "Still going?\n\n" + staleCommentMarker, CreatedAt: now.AddDate(0, 0, -10)}},
+		4: {{Body: "Still going?", CreatedAt: now.AddDate(0, 0, -1)}},
+	}}
+	nudger := &staleNudger{
+		issueClient: api,
+		now:         now,
+		actions:     map[string]bool{"label": true, "comment": true},
+		label:       "stale",
+		comment:     defaultStaleComment,
+	}
+	items := []staleItem{
+		{Number: 1, Title: "Labelled", labels: []string{"Stale"}, threshold: week},
+		{Number: 2, Title: "Nudged this week", threshold: week},
+		{Number: 3, Title: "Nudged before the threshold", threshold: week},
+		{Number: 4, Title: "Commented on by hand", threshold: week},
+	}
+
+	require.NoError(t, nudger.nudge(items))
+	assert.Equal(t, []int{2, 3, 4}, api.labelled, "items that carry the label are not labelled again")
+	assert.Equal(t, []int{1, 3, 4}, api.posted, "only nudge comments within the threshold count")
+
+	api.labelled, api.posted = nil, nil
+	for i := range items {
+		items[i].labels = []string{"stale"}
+	}
+	require.NoError(t, nudger.nudge(items))
+	assert.Empty(t, api.labelled)
+	assert.Empty(t, api.posted, "a second run does not repeat the nudges")
+}
+
+func TestStaleNudgerMoveFollowsWorkflow(t *testing.T) {
+	cfg := workflowTestConfig("")
+	newItems := func() []staleItem {
+		return []staleItem{
+			{Number: 1, Title: "Abandoned", Status: "In Progress", itemID: "PVTI_1"},
+			{Number: 2, Title: "Parked", Status: "Ready", itemID: "PVTI_2"},
+		}
+	}
+	newNudger := func(force bool, out *bytes.Buffer) (*staleNudger, *fakeStaleAPI) {
+		api := &fakeStaleAPI{}
+		return &staleNudger{
+			issueClient: api,
+			actions:     map[string]bool{"move": true},
+			moveTo:      "Backlog",
+			statusField: &project.Field{ID: "F_status", Name: "Status", Options: []project.FieldOption{{ID: "O_backlog", Name: "Backlog"}}},
+			guard:       newWorkflowGuard(cfg, force, out, workflowTestItems("In Progress", "Ready")),
+		}, api
+	}
+
+	var out bytes.Buffer
+	nudger, api := newNudger(false, &out)
+	items := newItems()
+	err := nudger.nudge(items)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to nudge 1 of 2 items")
+	assert.Equal(t, []string{"PVTI_2"}, api.moved, "in_progress → backlog is not an allowed transition")
+	require.Len(t, items[0].Errors, 1)
+	assert.Contains(t, items[0].Errors[0], "breaks the workflow")
+	assert.Equal(t, []string{"move to Backlog"}, items[1].Actions, "the report records the actions taken")
+
+	out.Reset()
+	nudger, api = newNudger(true, &out)
+	require.NoError(t, nudger.nudge(newItems()))
+	assert.Equal(t, []string{"PVTI_1", "PVTI_2"}, api.moved)
+	assert.Contains(t, out.String(), "⚠ #1: in_progress → backlog is not an allowed transition (allowed: done, ready) (forced)")
+}
@@ -0,0 +1,357 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var (
+	standupSince     string
+	standupStuckDays int
+	standupFormat    string
+	standupLimit     int
+)
+
+// standupCmd summarizes the project activity since the last business day
+var standupCmd = &cobra.Command{
+	Use:   "standup",
+	Short: "Summarize recent project activity per assignee",
+	Long: `Report, per assignee, the project items that were finished, started or blocked
+since the last business day, the items newly added to the project, and the
+items that have stayed in the same status for too long.
+
+The window starts on the previous business day (Friday on Mondays) unless
+--since is given, as a date or as @today-Nd. Status names come from the
+"done", "in_progress" and "blocked" values of fields.status in .gh-pm.yml.
+Use --format markdown for text ready to paste into chat.`,
+	Example: `  # Since the last business day
+  gh pm standup
+
+  # Since Monday, as Markdown
+  gh pm standup --since @today-3d --format markdown
+
+  # Flag items stuck for more than 5 days
+  gh pm standup --stuck-days 5`,
+	Args: cobra.NoArgs,
+	RunE: runStandup,
+}
+
+func init() {
+	rootCmd.AddCommand(standupCmd)
+	standupCmd.Flags().StringVar(&standupSince, "since", "", "Start of the window, as YYYY-MM-DD or @today-Nd (default: the last business day)")
+	standupCmd.Flags().IntVar(&standupStuckDays, "stuck-days", 3, "Flag items that have stayed in one status longer than this many days")
+	standupCmd.Flags().StringVar(&standupFormat, "format", "table", "Output format: table or markdown")
+	standupCmd.Flags().IntVarP(&standupLimit, "limit", "L", 500, "Maximum number of project items to read")
+}
+
+// standupEntry is one item in a standup section
+type standupEntry struct {
+	Number int       `json:"number"`
+	Title  string    `json:"title"`
+	URL    string    `json:"url"`
+	Status string    `json:"status,omitempty"`
+	At     time.Time `json:"at"`             // When the change happened, or when a stuck item entered its status
+	Days   int       `json:"days,omitempty"` // Days in the status, for stuck items
+}
+
+// standupAssignee is the activity of one assignee
+type standupAssignee struct {
+	Assignee string         `json:"assignee"`
+	Done     []standupEntry `json:"done,omitempty"`
+	Started  []standupEntry `json:"started,omitempty"`
+	Blocked  []standupEntry `json:"blocked,omitempty"`
+	Added    []standupEntry `json:"added,omitempty"`
+	Stuck    []standupEntry `json:"stuck,omitempty"`
+}
+
+// standupReport is the activity since a date, per assignee
+type standupReport struct {
+	Since     string            `json:"since"`
+	StuckDays int               `json:"stuck_days"`
+	Assignees []standupAssignee `json:"assignees"`
+}
+
+// standupStatuses are the project status names the report looks for
+type standupStatuses struct {
+	Field   string
+	Done    string
+	Started string
+	Blocked string
+}
+
+func runStandup(cmd *cobra.Command, args []string) error {
+	format := standupFormat
+	if !cmd.Flags().Changed("format") && outputFormat == "json" {
+		format = "json"
+	}
+	if format != "table" && format != "markdown" && format != "json" {
+		return fmt.Errorf("invalid format: %s (expected table or markdown)", format)
+	}
+
+	now := time.Now()
+	sinceValue := standupSince
+	if sinceValue == "" {
+		sinceValue = lastBusinessDay(now)
+	}
+	since, err := parseSinceDate(sinceValue)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+
+	projectClient, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, projectClient)
+	if err != nil {
+		return err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, standupLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	statuses := standupStatuses{
+		Field:   statusFieldName(cfg),
+		Done:    statusOptionName(cfg, "done", "Done"),
+		Started: statusOptionName(cfg, "in_progress", "In Progress"),
+		Blocked: statusOptionName(cfg, "blocked", "Blocked"),
+	}
+	histories := fetchHistories(issue.NewClient(), items, func(item filter.ProjectIssue) bool {
+		return standupNeedsHistory(cfg, statuses, item, since)
+	})
+	report := buildStandupReport(cfg, items, histories, projectID, statuses, since, now, standupStuckDays)
+
+	switch format {
+	case "json":
+		return output.NewFormatter(output.FormatJSON).Format(report)
+	case "markdown":
+		fmt.Print(renderStandupMarkdown(report))
+	default:
+		fmt.Print(renderStandupTable(report))
+	}
+	return nil
+}
+
+// standupNeedsHistory reports whether the item can have activity in the
+// window: its issue or project item, which moving it on the board updates, was
+// updated in it, or it is open and may be stuck in its status
+func standupNeedsHistory(cfg *config.Config, statuses standupStatuses, item filter.ProjectIssue, since time.Time) bool {
+	status, _ := item.Fields[statuses.Field].(string)
+	return updatedSince(item, since) || (status != "" && !isItemFinished(cfg, item))
+}
+
+// lastBusinessDay returns the @today-Nd expression for the previous weekday
+func lastBusinessDay(now time.Time) string {
+	days := 1
+	switch now.Weekday() {
+	case time.Monday:
+		days = 3
+	case time.Sunday:
+		days = 2
+	}
+	return fmt.Sprintf("@today-%dd", days)
+}
+
+// buildStandupReport sorts the recent status changes and additions of each
+// item, and the items stuck in their status, under each of its assignees
+func buildStandupReport(cfg *config.Config, items []filter.ProjectIssue, histories [][]issue.HistoryEvent, projectID string, statuses standupStatuses, since, now time.Time, stuckDays int) standupReport {
+	report := standupReport{
+		Since:     since.Format("2006-01-02"),
+		StuckDays: stuckDays,
+		Assignees: []standupAssignee{},
+	}
+	byAssignee := map[string]*standupAssignee{}
+	add := func(item filter.ProjectIssue, section func(*standupAssignee) *[]standupEntry, entry standupEntry) {
+		assignees := item.Assignees
+		if len(assignees) == 0 {
+			assignees = []string{unassignedName}
+		}
+		for _, name := range assignees {
+			if byAssignee[name] == nil {
+				byAssignee[name] = &standupAssignee{Assignee: name}
+			}
+			list := section(byAssignee[name])
+			*list = append(*list, entry)
+		}
+	}
+
+	for i, item := range items {
+		var history []issue.HistoryEvent
+		if i < len(histories) {
+			history = histories[i]
+		}
+		status, _ := item.Fields[statuses.Field].(string)
+		entry := func(at time.Time) standupEntry {
+			return standupEntry{Number: item.Number, Title: item.Title, URL: item.URL, Status: status, At: at}
+		}
+
+		// The latest change into each status within the window
+		var done, started, blocked, added *time.Time
+		var enteredAt *time.Time
+		for _, event := range history {
+			if event.ProjectID != "" && event.ProjectID != projectID {
+				continue
+			}
+			at := event.CreatedAt
+			switch event.Type {
+			case issue.HistoryAddedToProject:
+				if enteredAt == nil {
+					enteredAt = &at
+				}
+				if !at.Before(since) {
+					added = &at
+				}
+			case issue.HistoryStatusChanged:
+				if strings.EqualFold(event.To, status) {
+					enteredAt = &at
+				}
+				if at.Before(since) {
+					continue
+				}
+				switch {
+				case strings.EqualFold(event.To, statuses.Done):
+					done = &at
+				case strings.EqualFold(event.To, statuses.Started):
+					started = &at
+				case strings.EqualFold(event.To, statuses.Blocked):
+					blocked = &at
+				}
+			}
+		}
+
+		if done != nil {
+			add(item, func(a *standupAssignee) *[]standupEntry { return &a.Done }, entry(*done))
+		}
+		if started != nil {
+			add(item, func(a *standupAssignee) *[]standupEntry { return &a.Started }, entry(*started))
+		}
+		if blocked != nil {
+			add(item, func(a *standupAssignee) *[]standupEntry { return &a.Blocked }, entry(*blocked))
+		}
+		if added != nil {
+			add(item, func(a *standupAssignee) *[]standupEntry { return &a.Added }, entry(*added))
+		}
+
+		if status == "" || enteredAt == nil || isItemFinished(cfg, item) {
+			continue
+		}
+		if days := int(now.Sub(*enteredAt).Hours() / 24); days > stuckDays {
+			stuck := entry(*enteredAt)
+			stuck.Days = days
+			add(item, func(a *standupAssignee) *[]standupEntry { return &a.Stuck }, stuck)
+		}
+	}
+
+	for _, assignee := range byAssignee {
+		report.Assignees = append(report.Assignees, *assignee)
+	}
+	sort.Slice(report.Assignees, func(i, j int) bool {
+		a, b := report.Assignees[i].Assignee, report.Assignees[j].Assignee
+		if (a == unassignedName) != (b == unassignedName) {
+			return b == unassignedName
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	return report
+}
+
+// standupSections lists the sections of an assignee with their labels
+func standupSections(assignee standupAssignee) []struct {
+	label   string
+	entries []standupEntry
+} {
+	return []struct {
+		label   string
+		entries []standupEntry
+	}{
+		{"Done", assignee.Done},
+		{"Started", assignee.Started},
+		{"Blocked", assignee.Blocked},
+		{"Added", assignee.Added},
+		{"Stuck", assignee.Stuck},
+	}
+}
+
+// standupEntryText describes an entry, with the status and days for stuck items
+func standupEntryText(label string, entry standupEntry) string {
+	text := fmt.Sprintf("#%d %s", entry.Number, entry.Title)
+	if label == "Stuck" {
+		text += fmt.Sprintf(" (%s for %dd)", entry.Status, entry.Days)
+	}
+	return text
+}
+
+func renderStandupTable(report standupReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Standup since %s\n", report.Since)
+	if len(report.Assignees) == 0 {
+		b.WriteString("\nNo activity\n")
+		return b.String()
+	}
+
+	for _, assignee := range report.Assignees {
+		fmt.Fprintf(&b, "\n%s\n", assignee.Assignee)
+		for _, section := range standupSections(assignee) {
+			for _, entry := range section.entries {
+				fmt.Fprintf(&b, "  %-8s %s\n", section.label, truncate(standupEntryText(section.label, entry), 80))
+			}
+		}
+	}
+	return b.String()
+}
+
+func renderStandupMarkdown(report standupReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Standup since %s\n", report.Since)
+	if len(report.Assignees) == 0 {
+		b.WriteString("\nNo activity\n")
+		return b.String()
+	}
+
+	for _, assignee := range report.Assignees {
+		name := assignee.Assignee
+		if name != unassignedName {
+			name = "@" + name
+		}
+		fmt.Fprintf(&b, "\n### %s\n", name)
+		for _, section := range standupSections(assignee) {
+			if len(section.entries) == 0 {
+				continue
+			}
+			var links []string
+			for _, entry := range section.entries {
+				text := standupEntryText(section.label, entry)
+				if entry.URL != "" {
+					text = fmt.Sprintf("[%s](%s)", text, entry.URL)
+				}
+				links = append(links, text)
+			}
+			fmt.Fprintf(&b, "- **%s:** %s\n", section.label, strings.Join(links, ", "))
+		}
+	}
+	return b.String()
+}
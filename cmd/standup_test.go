@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func TestLastBusinessDay(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local), "@today-3d"}, // Monday
+		{time.Date(2026, 10, 20, 9, 0, 0, 0, time.Local), "@today-1d"}, // Tuesday
+		{time.Date(2026, 10, 24, 9, 0, 0, 0, time.Local), "@today-1d"}, // Saturday
+		{time.Date(2026, 10, 25, 9, 0, 0, 0, time.Local), "@today-2d"}, // Sunday
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.Weekday().String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, lastBusinessDay(tt.date))
+		})
+	}
+}
+
+func TestBuildStandupReport(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"done": "Done", "in_progress": "In Progress"}},
+	}}
+	statuses := standupStatuses{Field: "Status", Done: "Done", Started: "In Progress", Blocked: "Blocked"}
+	now := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	since := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+	added := func(at time.Time, projectID string) issue.HistoryEvent {
+		return issue.HistoryEvent{Type: issue.HistoryAddedToProject, CreatedAt: at, ProjectID: projectID}
+	}
+
+	items := []filter.ProjectIssue{
+		{Number: 1, Title: "Done yesterday", State: "closed", Assignees: []string{"bob"}, Fields: map[string]interface{}{"Status": "Done"}},
+		{Number: 2, Title: "Started and blocked", Assignees: []string{"alice", "bob"}, Fields: map[string]interface{}{"Status": "Blocked"}},
+		{Number: 3, Title: "New", Fields: map[string]interface{}{"Status": "Todo"}},
+		{Number: 4, Title: "Stuck in review", Assignees: []string{"alice"}, Fields: map[string]interface{}{"Status": "Review"}},
+		{Number: 5, Title: "Quietly in progress", Assignees: []string{"alice"}, Fields: map[string]interface{}{"Status": "In Progress"}},
+		{Number: 6, Title: "Other project", Assignees: []string{"carol"}, Fields: map[string]interface{}{"Status": "Todo"}},
+	}
+	histories := [][]issue.HistoryEvent{
+		{statusEvent(day(-4), "P_1", "Todo", "In Progress"), statusEvent(day(-1).Add(2*time.Hour), "P_1", "In Progress", "Done")},
+		{statusEvent(day(-1), "P_1", "Todo", "In Progress"), statusEvent(day(-1).Add(time.Hour), "P_1", "In Progress", "Blocked")},
+		{added(day(0).Add(-time.Hour), "P_1")},
+		{added(day(-20), "P_1"), statusEvent(day(-6), "P_1", "In Progress", "Review")},
+		{added(day(-2), "P_1")},
+		{added(day(0), "P_2"), statusEvent(day(0), "P_2", "Todo", "Done")},
+	}
+
+	report := buildStandupReport(cfg, items, histories, "P_1", statuses, since, now, 3)
+	assert.Equal(t, "2026-10-19", report.Since)
+
+	var names []string
+	for _, assignee := range report.Assignees {
+		names = append(names, assignee.Assignee)
+	}
+	require.Equal(t, []string{"alice", "bob", unassignedName}, names, "other projects are ignored, unassigned last")
+
+	alice, bob, unassigned := report.Assignees[0], report.Assignees[1], report.Assignees[2]
+	numbers := func(entries []standupEntry) []int {
+		var result []int
+		for _, entry := range entries {
+			result = append(result, entry.Number)
+		}
+		return result
+	}
+	assert.Equal(t, []int{2}, numbers(alice.Started))
+	assert.Equal(t, []int{2}, numbers(alice.Blocked))
+	assert.Equal(t, []int{4}, numbers(alice.Stuck), "items not moved since being added are stuck from when they were added")
+	assert.Equal(t, 6, alice.Stuck[0].Days)
+	assert.Equal(t, []int{1}, numbers(bob.Done))
+	assert.Equal(t, []int{2}, numbers(bob.Started), "#1 was started before the window")
+	assert.Equal(t, []int{2}, numbers(bob.Blocked))
+	assert.Equal(t, []int{3}, numbers(unassigned.Added))
+}
+
+func TestStandupNeedsHistory(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"done": "Done"}},
+	}}
+	statuses := standupStatuses{Field: "Status", Done: "Done"}
+	since := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	before, after := "2026-10-12T09:00:00Z", "2026-10-19T15:00:00Z"
+
+	tests := []struct {
+		name string
+		item filter.ProjectIssue
+		want bool
+	}{
+		{"issue updated", filter.ProjectIssue{State: "closed", UpdatedAt: after}, true},
+		{"dragged to done on the board", filter.ProjectIssue{UpdatedAt: before, ItemUpdatedAt: after, Fields: map[string]interface{}{"Status": "Done"}}, true},
+		{"added to the project", filter.ProjectIssue{UpdatedAt: before, ItemUpdatedAt: after}, true},
+		{"open and possibly stuck", filter.ProjectIssue{UpdatedAt: before, ItemUpdatedAt: before, Fields: map[string]interface{}{"Status": "In Progress"}}, true},
+		{"finished before the window", filter.ProjectIssue{UpdatedAt: before, ItemUpdatedAt: before, Fields: map[string]interface{}{"Status": "Done"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, standupNeedsHistory(cfg, statuses, tt.item, since))
+		})
+	}
+}
+
+func TestRenderStandupMarkdown(t *testing.T) {
+	report := standupReport{
+		Since: "2026-10-19",
+		Assignees: []standupAssignee{
+			{
+				Assignee: "alice",
+				Done:     []standupEntry{{Number: 1, Title: "Login", URL: "https://github.com/o/r/issues/1"}},
+				Stuck:    []standupEntry{{Number: 4, Title: "Review", Status: "In Review", Days: 5}},
+			},
+			{Assignee: unassignedName, Added: []standupEntry{{Number: 3, Title: "New"}}},
+		},
+	}
+
+	assert.Equal(t, `## Standup since 2026-10-19
+
+### @alice
+- **Done:** [#1 Login](https://github.com/o/r/issues/1)
+- **Stuck:** #4 Review (In Review for 5d)
+
+### (unassigned)
+- **Added:** #3 New
+`, renderStandupMarkdown(report))
+
+	assert.Equal(t, "## Standup since 2026-10-19\n\nNo activity\n", renderStandupMarkdown(standupReport{Since: "2026-10-19"}))
+}
@@ -0,0 +1,540 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+	"github.com/yahsan2/gh-pm/pkg/utils"
+)
+
+// historyConcurrency is how many item histories are fetched at once
+const historyConcurrency = 8
+
+var (
+	statsSince string
+	statsLimit int
+)
+
+// statsCmd groups the project analytics commands
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Project analytics",
+	Long:  `Analytics computed from the project items and their status history.`,
+}
+
+// statsCycleTimeCmd reports how long items take to be started and finished
+var statsCycleTimeCmd = &cobra.Command{
+	Use:   "cycle-time",
+	Short: "Report time to start, cycle time and lead time",
+	Long: `Report, for the project items started or finished in the period, how long
+they took from creation to first entering "in_progress" (time to start), from
+"in_progress" to "done" (cycle time) and from creation to "done" (lead time).
+
+Times come from the project status-change events of each item. "in_progress" and
+"done" are resolved through the status values in .gh-pm.yml, so they match the
+team's actual option names.
+
+The report shows percentiles, a breakdown by priority and the items whose cycle
+time is unusually long.`,
+	Example: `  # Items started or finished in the last 30 days
+  gh pm stats cycle-time --since @today-30d
+
+  # Per-item data for a spreadsheet
+  gh pm stats cycle-time --since 2026-01-01 --output csv`,
+	Args: cobra.NoArgs,
+	RunE: runStatsCycleTime,
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	statsCmd.AddCommand(statsCycleTimeCmd)
+	statsCycleTimeCmd.Flags().StringVar(&statsSince, "since", "@today-30d", "Start of the period (YYYY-MM-DD or @today-Nd)")
+	statsCycleTimeCmd.Flags().IntVarP(&statsLimit, "limit", "L", 500, "Maximum number of project items to analyze")
+}
+
+// cycleTimeItem is the timing of one project item; durations are in days
+type cycleTimeItem struct {
+	Number      int        `json:"number"`
+	Title       string     `json:"title"`
+	Priority    string     `json:"priority,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	DoneAt      *time.Time `json:"done_at,omitempty"`
+	TimeToStart *float64   `json:"time_to_start,omitempty"`
+	CycleTime   *float64   `json:"cycle_time,omitempty"`
+	LeadTime    *float64   `json:"lead_time,omitempty"`
+	Outlier     bool       `json:"outlier"`
+}
+
+// durationStats summarizes a set of durations in days
+type durationStats struct {
+	Count   int     `json:"count"`
+	Average float64 `json:"average"`
+	P50     float64 `json:"p50"`
+	P75     float64 `json:"p75"`
+	P90     float64 `json:"p90"`
+	Max     float64 `json:"max"`
+}
+
+// priorityBreakdown is the cycle and lead time of the items of one priority
+type priorityBreakdown struct {
+	Priority  string        `json:"priority"`
+	Items     int           `json:"items"`
+	CycleTime durationStats `json:"cycle_time"`
+	LeadTime  durationStats `json:"lead_time"`
+}
+
+// cycleTimeReport is the result of stats cycle-time
+type cycleTimeReport struct {
+	Since       string              `json:"since"`
+	StartStatus string              `json:"start_status"`
+	DoneStatus  string              `json:"done_status"`
+	TimeToStart durationStats       `json:"time_to_start"`
+	CycleTime   durationStats       `json:"cycle_time"`
+	LeadTime    durationStats       `json:"lead_time"`
+	ByPriority  []priorityBreakdown `json:"by_priority"`
+	OutlierOver float64             `json:"outlier_over,omitempty"` // Cycle time above which items are outliers
+	Items       []cycleTimeItem     `json:"items"`
+}
+
+func runStatsCycleTime(cmd *cobra.Command, args []string) error {
+	since, err := parseSinceDate(statsSince)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+
+	projectClient, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, projectClient)
+	if err != nil {
+		return err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, statsLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	startStatus := statusOptionName(cfg, "in_progress", "In Progress")
+	doneStatus := statusOptionName(cfg, "done", "Done")
+	priorityField := priorityFieldName(cfg)
+
+	// Items whose issue and project item were not updated since the start of
+	// the period cannot have started or finished within it
+	histories := fetchHistories(issue.NewClient(), items, func(item filter.ProjectIssue) bool {
+		return updatedSince(item, since)
+	})
+	var timings []cycleTimeItem
+	for i, item := range items {
+		if histories[i] == nil {
+			continue
+		}
+		timing := computeCycleTime(item, histories[i], projectID, startStatus, doneStatus)
+		if priority, ok := item.Fields[priorityField].(string); ok {
+			timing.Priority = priority
+		}
+		timings = append(timings, timing)
+	}
+
+	report := buildCycleTimeReport(timings, since)
+	report.StartStatus = startStatus
+	report.DoneStatus = doneStatus
+
+	switch outputFormat {
+	case "json":
+		return output.NewFormatter(output.FormatJSON).Format(report)
+	case "csv":
+		return writeCycleTimeCSV(report)
+	default:
+		return printCycleTimeReport(report)
+	}
+}
+
+// historyReader reads the timeline of an issue
+type historyReader interface {
+	GetIssueHistory(issueID string) ([]issue.HistoryEvent, error)
+}
+
+// fetchHistories reads the history of the items that pass include, a few at a
+// time. Items that are left out or whose history fails get a nil history.
+func fetchHistories(reader historyReader, items []filter.ProjectIssue, include func(filter.ProjectIssue) bool) [][]issue.HistoryEvent {
+	histories := make([][]issue.HistoryEvent, len(items))
+	slots := make(chan struct{}, historyConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		if !include(item) {
+			continue
+		}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, item filter.ProjectIssue) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			history, err := reader.GetIssueHistory(item.ID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get history of #%d: %v\n", item.Number, err)
+				return
+			}
+			if history == nil {
+				history = []issue.HistoryEvent{}
+			}
+			histories[i] = history
+		}(i, item)
+	}
+	wg.Wait()
+	return histories
+}
+
+// updatedSince reports whether the issue or its project item was updated at or
+// after the time, or neither has a readable update time. Moving an item on the
+// board only updates the project item.
+func updatedSince(item filter.ProjectIssue, since time.Time) bool {
+	readable := false
+	for _, value := range []string{item.ItemUpdatedAt, item.UpdatedAt} {
+		if value == "" {
+			continue
+		}
+		updated, err := time.Parse(time.RFC3339, value)
+		if err != nil || !updated.Before(since) {
+			return true
+		}
+		readable = true
+	}
+	return !readable
+}
+
+// resolveProjectID returns the configured project's node ID, looking it up
+// and caching it when needed
+func resolveProjectID(cfg *config.Config, client *project.Client) (string, error) {
+	if projectID := cfg.GetProjectID(); projectID != "" {
+		return projectID, nil
+	}
+
+	var proj *project.Project
+	var err error
+	if cfg.Project.Org != "" {
+		proj, err = client.GetProject(cfg.Project.Org, cfg.Project.Name, cfg.Project.Number)
+	} else {
+		proj, err = client.GetCurrentUserProject(cfg.Project.Name, cfg.Project.Number)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+	cfg.SetProjectID(proj.ID)
+	return proj.ID, nil
+}
+
+// statusOptionName maps a status key such as "in_progress" to the project's
+// option name through the config, falling back to the given name
+func statusOptionName(cfg *config.Config, key, fallback string) string {
+	if status, ok := cfg.Fields["status"]; ok {
+		if name, ok := status.Values[key]; ok && name != "" {
+			return name
+		}
+	}
+	return fallback
+}
+
+// priorityFieldName returns the project field configured for priority
+func priorityFieldName(cfg *config.Config) string {
+	if priority, ok := cfg.Fields["priority"]; ok && priority.Field != "" {
+		return priority.Field
+	}
+	return "Priority"
+}
+
+// parseSinceDate parses a YYYY-MM-DD or @today-Nd date as local midnight
+func parseSinceDate(value string) (time.Time, error) {
+	iso, err := utils.ConvertProjectsDateToISO(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: %w", value, err)
+	}
+	date, err := time.ParseInLocation("2006-01-02", iso, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or @today-Nd", value)
+	}
+	return date, nil
+}
+
+// computeCycleTime finds when the item first entered the start status and
+// when it last entered the done status, if it is still there
+func computeCycleTime(item filter.ProjectIssue, history []issue.HistoryEvent, projectID, startStatus, doneStatus string) cycleTimeItem {
+	timing := cycleTimeItem{Number: item.Number, Title: item.Title}
+	timing.CreatedAt, _ = time.Parse(time.RFC3339, item.CreatedAt)
+
+	var lastStatus string
+	var lastDone *time.Time
+	for _, event := range history {
+		if event.Type != issue.HistoryStatusChanged || (event.ProjectID != "" && event.ProjectID != projectID) {
+			continue
+		}
+		at := event.CreatedAt
+		if timing.StartedAt == nil && strings.EqualFold(event.To, startStatus) {
+			timing.StartedAt = &at
+		}
+		if strings.EqualFold(event.To, doneStatus) {
+			lastDone = &at
+		}
+		lastStatus = event.To
+	}
+	if lastDone != nil && strings.EqualFold(lastStatus, doneStatus) {
+		timing.DoneAt = lastDone
+	}
+
+	if timing.StartedAt != nil && !timing.CreatedAt.IsZero() {
+		timing.TimeToStart = daysBetween(timing.CreatedAt, *timing.StartedAt)
+	}
+	if timing.StartedAt != nil && timing.DoneAt != nil && timing.DoneAt.After(*timing.StartedAt) {
+		timing.CycleTime = daysBetween(*timing.StartedAt, *timing.DoneAt)
+	}
+	if timing.DoneAt != nil && !timing.CreatedAt.IsZero() {
+		timing.LeadTime = daysBetween(timing.CreatedAt, *timing.DoneAt)
+	}
+	return timing
+}
+
+// daysBetween returns the days from start to end, rounded to a tenth
+func daysBetween(start, end time.Time) *float64 {
+	days := math.Round(end.Sub(start).Hours()/24*10) / 10
+	return &days
+}
+
+// buildCycleTimeReport keeps the items started or finished since the given
+// date and computes the statistics, priority breakdown and outliers
+func buildCycleTimeReport(timings []cycleTimeItem, since time.Time) cycleTimeReport {
+	report := cycleTimeReport{Since: since.Format("2006-01-02"), Items: []cycleTimeItem{}, ByPriority: []priorityBreakdown{}}
+
+	inPeriod := func(at *time.Time) bool { return at != nil && !at.Before(since) }
+	byPriority := make(map[string][]cycleTimeItem)
+	for _, timing := range timings {
+		if !inPeriod(timing.StartedAt) && !inPeriod(timing.DoneAt) {
+			continue
+		}
+		report.Items = append(report.Items, timing)
+		priority := timing.Priority
+		if priority == "" {
+			priority = "(none)"
+		}
+		byPriority[priority] = append(byPriority[priority], timing)
+	}
+
+	report.TimeToStart = summarizeDurations(report.Items, func(t cycleTimeItem) *float64 { return t.TimeToStart })
+	report.CycleTime = summarizeDurations(report.Items, func(t cycleTimeItem) *float64 { return t.CycleTime })
+	report.LeadTime = summarizeDurations(report.Items, func(t cycleTimeItem) *float64 { return t.LeadTime })
+
+	priorities := make([]string, 0, len(byPriority))
+	for priority := range byPriority {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool {
+		if (priorities[i] == "(none)") != (priorities[j] == "(none)") {
+			return priorities[j] == "(none)"
+		}
+		return priorities[i] < priorities[j]
+	})
+	for _, priority := range priorities {
+		group := byPriority[priority]
+		report.ByPriority = append(report.ByPriority, priorityBreakdown{
+			Priority:  priority,
+			Items:     len(group),
+			CycleTime: summarizeDurations(group, func(t cycleTimeItem) *float64 { return t.CycleTime }),
+			LeadTime:  summarizeDurations(group, func(t cycleTimeItem) *float64 { return t.LeadTime }),
+		})
+	}
+
+	// Tukey's fences: outliers lie 1.5 interquartile ranges above the third quartile
+	cycleTimes := collectDurations(report.Items, func(t cycleTimeItem) *float64 { return t.CycleTime })
+	if len(cycleTimes) >= 4 {
+		q1, q3 := percentile(cycleTimes, 25), percentile(cycleTimes, 75)
+		report.OutlierOver = math.Round((q3+1.5*(q3-q1))*10) / 10
+		for i := range report.Items {
+			if cycleTime := report.Items[i].CycleTime; cycleTime != nil && *cycleTime > report.OutlierOver {
+				report.Items[i].Outlier = true
+			}
+		}
+	}
+	return report
+}
+
+// collectDurations returns the sorted non-nil durations selected by value
+func collectDurations(timings []cycleTimeItem, value func(cycleTimeItem) *float64) []float64 {
+	var values []float64
+	for _, timing := range timings {
+		if v := value(timing); v != nil {
+			values = append(values, *v)
+		}
+	}
+	sort.Float64s(values)
+	return values
+}
+
+// summarizeDurations computes the count, average, percentiles and maximum
+func summarizeDurations(timings []cycleTimeItem, value func(cycleTimeItem) *float64) durationStats {
+	values := collectDurations(timings, value)
+	if len(values) == 0 {
+		return durationStats{}
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return durationStats{
+		Count:   len(values),
+		Average: math.Round(sum/float64(len(values))*10) / 10,
+		P50:     percentile(values, 50),
+		P75:     percentile(values, 75),
+		P90:     percentile(values, 90),
+		Max:     values[len(values)-1],
+	}
+}
+
+// percentile interpolates the p-th percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	value := sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+	return math.Round(value*10) / 10
+}
+
+// formatDays prints a duration in days, or "-" when there is none
+func formatDays(days *float64) string {
+	if days == nil {
+		return "-"
+	}
+	return formatNumber(*days) + "d"
+}
+
+func printCycleTimeReport(report cycleTimeReport) error {
+	fmt.Printf("Cycle time since %s (%s → %s), %d items\n\n", report.Since, report.StartStatus, report.DoneStatus, len(report.Items))
+	if len(report.Items) == 0 {
+		fmt.Println("No items were started or finished in this period")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "METRIC\tITEMS\tAVG\tP50\tP75\tP90\tMAX\n")
+	for _, metric := range []struct {
+		name  string
+		stats durationStats
+	}{
+		{"Time to start", report.TimeToStart},
+		{"Cycle time", report.CycleTime},
+		{"Lead time", report.LeadTime},
+	} {
+		s := metric.stats
+		if s.Count == 0 {
+			fmt.Fprintf(w, "%s\t0\t-\t-\t-\t-\t-\n", metric.name)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n", metric.name, s.Count,
+			formatDays(&s.Average), formatDays(&s.P50), formatDays(&s.P75), formatDays(&s.P90), formatDays(&s.Max))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "PRIORITY\tITEMS\tCYCLE P50\tCYCLE P90\tLEAD P50\tLEAD P90\n")
+	for _, group := range report.ByPriority {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", group.Priority, group.Items,
+			statsCell(group.CycleTime, group.CycleTime.P50), statsCell(group.CycleTime, group.CycleTime.P90),
+			statsCell(group.LeadTime, group.LeadTime.P50), statsCell(group.LeadTime, group.LeadTime.P90))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	header := false
+	for _, item := range report.Items {
+		if !item.Outlier {
+			continue
+		}
+		if !header {
+			fmt.Printf("\nOutliers (cycle time over %sd):\n", formatNumber(report.OutlierOver))
+			header = true
+		}
+		fmt.Printf("  #%d %s  %s\n", item.Number, item.Title, formatDays(item.CycleTime))
+	}
+	return nil
+}
+
+// statsCell prints a statistic, or "-" when the group has no durations
+func statsCell(stats durationStats, value float64) string {
+	if stats.Count == 0 {
+		return "-"
+	}
+	return formatDays(&value)
+}
+
+// writeCycleTimeCSV writes one row per item
+func writeCycleTimeCSV(report cycleTimeReport) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"Number", "Title", "Priority", "Created", "Started", "Done", "Time to start (days)", "Cycle time (days)", "Lead time (days)", "Outlier"})
+
+	formatTime := func(at *time.Time) string {
+		if at == nil {
+			return ""
+		}
+		return at.Format(time.RFC3339)
+	}
+	formatValue := func(days *float64) string {
+		if days == nil {
+			return ""
+		}
+		return formatNumber(*days)
+	}
+
+	for _, item := range report.Items {
+		created := item.CreatedAt
+		_ = w.Write([]string{
+			strconv.Itoa(item.Number),
+			item.Title,
+			item.Priority,
+			formatTime(&created),
+			formatTime(item.StartedAt),
+			formatTime(item.DoneAt),
+			formatValue(item.TimeToStart),
+			formatValue(item.CycleTime),
+			formatValue(item.LeadTime),
+			strconv.FormatBool(item.Outlier),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
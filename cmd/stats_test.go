@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func statusEvent(at time.Time, projectID, from, to string) issue.HistoryEvent {
+	return issue.HistoryEvent{Type: issue.HistoryStatusChanged, CreatedAt: at, ProjectID: projectID, Field: "Status", From: from, To: to}
+}
+
+func TestComputeCycleTime(t *testing.T) {
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return created.AddDate(0, 0, n) }
+	item := filter.ProjectIssue{Number: 7, Title: "Login", CreatedAt: created.Format(time.RFC3339)}
+
+	tests := []struct {
+		name        string
+		history     []issue.HistoryEvent
+		timeToStart *float64
+		cycleTime   *float64
+		leadTime    *float64
+	}{
+		{
+			name: "started and done",
+			history: []issue.HistoryEvent{
+				statusEvent(day(2), "P_1", "Todo", "In Progress"),
+				statusEvent(day(3), "P_1", "In Progress", "Review"),
+				statusEvent(day(5), "P_1", "Review", "Done"),
+			},
+			timeToStart: estimate(2), cycleTime: estimate(3), leadTime: estimate(5),
+		},
+		{
+			name: "reopened after done uses the final done and the first start",
+			history: []issue.HistoryEvent{
+				statusEvent(day(1), "P_1", "Todo", "in progress"),
+				statusEvent(day(2), "P_1", "In Progress", "Done"),
+				statusEvent(day(3), "P_1", "Done", "In Progress"),
+				statusEvent(day(6), "P_1", "In Progress", "Done"),
+			},
+			timeToStart: estimate(1), cycleTime: estimate(5), leadTime: estimate(6),
+		},
+		{
+			name: "moved out of done is not done",
+			history: []issue.HistoryEvent{
+				statusEvent(day(1), "P_1", "Todo", "In Progress"),
+				statusEvent(day(2), "P_1", "In Progress", "Done"),
+				statusEvent(day(3), "P_1", "Done", "Todo"),
+			},
+			timeToStart: estimate(1),
+		},
+		{
+			name: "events of other projects are ignored",
+			history: []issue.HistoryEvent{
+				statusEvent(day(1), "P_other", "Todo", "In Progress"),
+				statusEvent(day(4), "P_1", "Todo", "Done"),
+			},
+			leadTime: estimate(4),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timing := computeCycleTime(item, tt.history, "P_1", "In Progress", "Done")
+			assert.Equal(t, tt.timeToStart, timing.TimeToStart)
+			assert.Equal(t, tt.cycleTime, timing.CycleTime)
+			assert.Equal(t, tt.leadTime, timing.LeadTime)
+		})
+	}
+}
+
+func TestBuildCycleTimeReport(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int) *time.Time {
+		value := since.AddDate(0, 0, days)
+		return &value
+	}
+	timing := func(number int, priority string, started, done *time.Time, cycle float64) cycleTimeItem {
+		return cycleTimeItem{Number: number, Priority: priority, StartedAt: started, DoneAt: done, CycleTime: estimate(cycle), LeadTime: estimate(cycle + 1)}
+	}
+
+	report := buildCycleTimeReport([]cycleTimeItem{
+		timing(1, "High", at(1), at(2), 1),
+		timing(2, "High", at(1), at(3), 2),
+		timing(3, "Low", at(1), at(4), 3),
+		timing(4, "", at(-10), at(5), 2),
+		timing(5, "Low", at(1), at(20), 20),
+		{Number: 6, StartedAt: at(-20), DoneAt: at(-5), CycleTime: estimate(15)},
+		{Number: 7, StartedAt: at(2)},
+	}, since)
+
+	require.Len(t, report.Items, 6, "items started and finished before the period are left out")
+	assert.Equal(t, 5, report.CycleTime.Count)
+	assert.Equal(t, 2.0, report.CycleTime.P50)
+	assert.Equal(t, 20.0, report.CycleTime.Max)
+	assert.Equal(t, 5.6, report.CycleTime.Average)
+
+	var priorities []string
+	for _, group := range report.ByPriority {
+		priorities = append(priorities, group.Priority)
+	}
+	assert.Equal(t, []string{"High", "Low", "(none)"}, priorities)
+	assert.Equal(t, 2, report.ByPriority[0].Items)
+	assert.Equal(t, 1.5, report.ByPriority[0].CycleTime.P50)
+
+	var outliers []int
+	for _, item := range report.Items {
+		if item.Outlier {
+			outliers = append(outliers, item.Number)
+		}
+	}
+	assert.Equal(t, []int{5}, outliers)
+	assert.Equal(t, 4.5, report.OutlierOver)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4}
+	assert.Equal(t, 2.5, percentile(values, 50))
+	assert.Equal(t, 4.0, percentile(values, 100))
+	assert.Equal(t, 1.0, percentile(values, 0))
+	assert.Equal(t, 0.0, percentile(nil, 50))
+}
+
+func TestStatusOptionName(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"in_progress": "Doing"}},
+	}}
+	assert.Equal(t, "Doing", statusOptionName(cfg, "in_progress", "In Progress"))
+	assert.Equal(t, "Done", statusOptionName(cfg, "done", "Done"))
+	assert.Equal(t, "Done", statusOptionName(&config.Config{}, "done", "Done"))
+}
+
+// fakeHistoryReader returns one status event per issue, failing for "bad"
+type fakeHistoryReader struct {
+	mu    sync.Mutex
+	reads []string
+}
+
+func (f *fakeHistoryReader) GetIssueHistory(issueID string) ([]issue.HistoryEvent, error) {
+	f.mu.Lock()
+	f.reads = append(f.reads, issueID)
+	f.mu.Unlock()
+	switch issueID {
+	case "bad":
+		return nil, fmt.Errorf("boom")
+	case "quiet":
+		return nil, nil
+	}
+	return []issue.HistoryEvent{{Type: issue.HistoryStatusChanged, To: issueID}}, nil
+}
+
+func TestFetchHistories(t *testing.T) {
+	since := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	items := []filter.ProjectIssue{
+		{Number: 1, ID: "recent", UpdatedAt: "2026-03-12T00:00:00Z"},
+		{Number: 2, ID: "old", UpdatedAt: "2026-03-01T00:00:00Z"},
+		{Number: 3, ID: "bad", UpdatedAt: "2026-03-12T00:00:00Z"},
+		{Number: 4, ID: "unknown"},
+		{Number: 5, ID: "quiet", UpdatedAt: "2026-03-10T00:00:00Z"},
+		{Number: 6, ID: "moved", UpdatedAt: "2026-03-01T00:00:00Z", ItemUpdatedAt: "2026-03-11T00:00:00Z"},
+		{Number: 7, ID: "idle", UpdatedAt: "2026-03-01T00:00:00Z", ItemUpdatedAt: "2026-03-02T00:00:00Z"},
+	}
+	reader := &fakeHistoryReader{}
+
+	histories := fetchHistories(reader, items, func(item filter.ProjectIssue) bool {
+		return updatedSince(item, since)
+	})
+
+	require.Len(t, histories, len(items))
+	assert.Equal(t, "recent", histories[0][0].To)
+	assert.Nil(t, histories[1], "not updated since, so not fetched")
+	assert.Nil(t, histories[2], "failed")
+	assert.Equal(t, "unknown", histories[3][0].To, "an unreadable update time is fetched")
+	assert.NotNil(t, histories[4], "an empty history is not a failure")
+	assert.Equal(t, "moved", histories[5][0].To, "moved on the board only, so the project item was updated")
+	assert.Nil(t, histories[6])
+	assert.ElementsMatch(t, []string{"recent", "bad", "unknown", "quiet", "moved"}, reader.reads)
+}
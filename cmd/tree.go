@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+)
+
+var (
+	treeRepo   string
+	treeDepth  int
+	treeJSON   bool
+	treeFormat string
+)
+
+// treeCmd renders an issue and its sub-issues as a tree
+var treeCmd = &cobra.Command{
+	Use:   "tree [issue number]",
+	Short: "Show an issue and its sub-issues as a tree",
+	Long: `Show an issue and all of its sub-issues, recursively, as a tree.
+
+Every level shows the issue state, its project Status, assignees and the
+percentage of issues below it that are closed.`,
+	Example: `  # Show the hierarchy below issue 123
+  gh pm tree 123
+
+  # Only two levels deep
+  gh pm tree 123 --depth 2
+
+  # Machine-readable output
+  gh pm tree 123 --json
+
+  # Mermaid flowchart, e.g. for a markdown document
+  gh pm tree 123 --format mermaid`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("requires exactly 1 argument")
+		}
+		if _, err := strconv.Atoi(args[0]); err != nil {
+			return fmt.Errorf("invalid issue number: %s", args[0])
+		}
+		return nil
+	},
+	RunE: runTree,
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+	treeCmd.Flags().StringVarP(&treeRepo, "repo", "r", "", "Repository (owner/repo format)")
+	treeCmd.Flags().IntVar(&treeDepth, "depth", 0, "Maximum levels of sub-issues to show (0 for all)")
+	treeCmd.Flags().BoolVar(&treeJSON, "json", false, "Output the tree as JSON")
+	treeCmd.Flags().StringVar(&treeFormat, "format", "text", "Tree format: text or mermaid")
+}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	issueNum, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue number: %s", args[0])
+	}
+	if treeDepth < 0 {
+		return fmt.Errorf("--depth must not be negative")
+	}
+	if treeFormat != "text" && treeFormat != "mermaid" {
+		return fmt.Errorf("invalid format: %s (expected text or mermaid)", treeFormat)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		// Configuration is optional for tree command
+		cfg = &config.Config{}
+	}
+
+	client := issue.NewClient()
+	root, err := client.GetIssueWithRepo(issueNum, treeRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	tree, err := client.GetIssueTree(root.ID, issue.TreeOptions{
+		StatusField: statusFieldName(cfg),
+		ProjectID:   cfg.GetProjectID(),
+		MaxDepth:    treeDepth,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get issue tree: %w", err)
+	}
+
+	if treeJSON || outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(tree)
+	}
+
+	var lines []string
+	if treeFormat == "mermaid" {
+		lines = renderIssueTreeMermaid(tree)
+	} else {
+		lines = append([]string{issueTreeLine(tree)}, renderIssueTree(tree.Children, "")...)
+	}
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// statusFieldName returns the project field configured for status
+func statusFieldName(cfg *config.Config) string {
+	if status, ok := cfg.Fields["status"]; ok && status.Field != "" {
+		return status.Field
+	}
+	return "Status"
+}
+
+// issueTreeLine describes one issue: number, title, state, status, assignees and progress
+func issueTreeLine(node *issue.IssueTree) string {
+	parts := []string{strings.ToLower(node.State)}
+	if node.Status != "" {
+		parts = append(parts, node.Status)
+	}
+	for _, assignee := range node.Assignees {
+		parts = append(parts, "@"+assignee)
+	}
+	parts = append(parts, treeProgress(node))
+
+	return fmt.Sprintf("#%d %s  [%s]", node.Number, node.Title, strings.Join(parts, ", "))
+}
+
+// treeProgress formats the completion of an issue, or notes that its
+// sub-issues lie beyond --depth
+func treeProgress(node *issue.IssueTree) string {
+	if node.Truncated {
+		return "sub-issues not shown"
+	}
+	progress := fmt.Sprintf("%d%%", node.Percent)
+	if node.Total > 0 {
+		progress += fmt.Sprintf(" (%d/%d", node.Completed, node.Total)
+		if node.Partial {
+			progress += " shown"
+		}
+		progress += ")"
+	}
+	return progress
+}
+
+// renderIssueTree draws the issues as an indented tree, like renderTaskTree
+func renderIssueTree(nodes []*issue.IssueTree, prefix string) []string {
+	var lines []string
+	for i, node := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		lines = append(lines, prefix+branch+issueTreeLine(node))
+		lines = append(lines, renderIssueTree(node.Children, prefix+indent)...)
+	}
+	return lines
+}
+
+// renderIssueTreeMermaid renders the tree as a mermaid flowchart; closed issues are styled as done
+func renderIssueTreeMermaid(root *issue.IssueTree) []string {
+	lines := []string{"graph TD"}
+	var closed []string
+
+	var walk func(node *issue.IssueTree)
+	walk = func(node *issue.IssueTree) {
+		id := fmt.Sprintf("I%d", node.Number)
+		label := fmt.Sprintf("#%d %s", node.Number, node.Title)
+		details := []string{strings.ToLower(node.State)}
+		if node.Status != "" {
+			details = append(details, node.Status)
+		}
+		switch {
+		case node.Truncated:
+			details = append(details, treeProgress(node))
+		case node.Partial:
+			details = append(details, fmt.Sprintf("%d%% of shown", node.Percent))
+		default:
+			details = append(details, fmt.Sprintf("%d%%", node.Percent))
+		}
+		lines = append(lines, fmt.Sprintf("    %s[\"%s<br/>%s\"]", id, mermaidEscape(label), mermaidEscape(strings.Join(details, " · "))))
+		if node.IsClosed() {
+			closed = append(closed, id)
+		}
+
+		for _, child := range node.Children {
+			walk(child)
+			lines = append(lines, fmt.Sprintf("    %s --> I%d", id, child.Number))
+		}
+	}
+	walk(root)
+
+	if len(closed) > 0 {
+		lines = append(lines, "    classDef closed fill:#e6ffed,stroke:#2da44e")
+		lines = append(lines, fmt.Sprintf("    class %s closed", strings.Join(closed, ",")))
+	}
+	return lines
+}
+
+// mermaidEscape makes text safe inside a quoted mermaid node label
+func mermaidEscape(text string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	return replacer.Replace(text)
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+)
+
+func sampleIssueTree() *issue.IssueTree {
+	return &issue.IssueTree{
+		Number: 1, Title: "Epic", State: "OPEN", Status: "In Progress", Assignees: []string{"alice"},
+		Completed: 1, Total: 2, Percent: 50,
+		Children: []*issue.IssueTree{
+			{Number: 2, Title: `Say "hi"`, State: "CLOSED", Percent: 100},
+			{Number: 3, Title: "Docs", State: "OPEN", Status: "Todo"},
+		},
+	}
+}
+
+func TestRenderIssueTree(t *testing.T) {
+	tree := sampleIssueTree()
+
+	lines := append([]string{issueTreeLine(tree)}, renderIssueTree(tree.Children, "")...)
+	assert.Equal(t, []string{
+		"#1 Epic  [open, In Progress, @alice, 50% (1/2)]",
+		`├── #2 Say "hi"  [closed, 100%]`,
+		"└── #3 Docs  [open, Todo, 0%]",
+	}, lines)
+}
+
+func TestRenderIssueTreeTruncated(t *testing.T) {
+	tree := sampleIssueTree()
+	tree.Children[0].Truncated = true
+	tree.Children[0].Percent = 0
+
+	assert.Equal(t, `├── #2 Say "hi"  [closed, sub-issues not shown]`, renderIssueTree(tree.Children, "")[0])
+
+	tree.Partial = true
+	assert.Equal(t, "#1 Epic  [open, In Progress, @alice, 50% (1/2 shown)]", issueTreeLine(tree), "the parent's progress covers only what is shown")
+	assert.Equal(t, `    I1["#1 Epic<br/>open · In Progress · 50% of shown"]`, renderIssueTreeMermaid(tree)[1])
+}
+
+func TestRenderIssueTreeMermaid(t *testing.T) {
+	assert.Equal(t, []string{
+		"graph TD",
+		`    I1["#1 Epic<br/>open · In Progress · 50%"]`,
+		`    I2["#2 Say #quot;hi#quot;<br/>closed · 100%"]`,
+		"    I1 --> I2",
+		`    I3["#3 Docs<br/>open · Todo · 0%"]`,
+		"    I1 --> I3",
+		"    classDef closed fill:#e6ffed,stroke:#2da44e",
+		"    class I2 closed",
+	}, renderIssueTreeMermaid(sampleIssueTree()))
+}
+
+func TestStatusFieldName(t *testing.T) {
+	assert.Equal(t, "Status", statusFieldName(&config.Config{}))
+	assert.Equal(t, "Stage", statusFieldName(&config.Config{
+		Fields: map[string]config.Field{"status": {Field: "Stage"}},
+	}))
+}
@@ -2,18 +2,25 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/yahsan2/gh-pm/pkg/config"
 	"github.com/yahsan2/gh-pm/pkg/filter"
 	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
 	"github.com/yahsan2/gh-pm/pkg/project"
+	"github.com/yahsan2/gh-pm/pkg/tui"
 )
 
 var triageCmd = &cobra.Command{
@@ -39,7 +46,20 @@ This command will:
 
   # Ad-hoc triage with interactive mode for specific fields
   gh pm triage --query="status:backlog" --interactive="status,estimate"
-  gh pm triage --query="-has:priority" --interactive="priority"`,
+  gh pm triage --query="-has:priority" --interactive="priority"
+
+  # Full-screen interactive triage with single-key shortcuts
+  gh pm triage tracked --tui
+
+  # Run every non-interactive triage configuration once
+  gh pm triage --all
+
+  # Keep running, applying rules to newly matching issues every 10 minutes
+  gh pm triage tracked --watch --interval 10m
+  gh pm triage --all --watch --output json
+
+  # Never touch more than 20 issues, and ask before touching more than 5
+  gh pm triage tracked --max-items 20 --confirm-over 5`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runTriage,
 }
@@ -48,8 +68,18 @@ func init() {
 	triageCmd.Flags().BoolP("list", "l", false, "List matching issues without applying changes")
 	triageCmd.Flags().Bool("dry-run", false, "Show what would be changed without making changes (alias for --list)")
 	triageCmd.Flags().String("query", "", "Query to filter issues (required when not using a named configuration)")
-	triageCmd.Flags().StringSlice("apply", []string{}, "Fields to apply (e.g., 'status:in-progress', 'label:bug')")
+	triageCmd.Flags().StringSlice("apply", []string{}, "Fields to apply (e.g., 'status:in-progress', 'label:bug', 'rollup')")
 	triageCmd.Flags().StringSlice("interactive", []string{}, "Fields to prompt for interactively (e.g., 'status', 'estimate', 'priority')")
+	triageCmd.Flags().Bool("tui", false, "Use a full-screen terminal UI for interactive fields")
+	triageCmd.Flags().String("on-conflict", "skip", "What to do when a field changed since it was read: skip, prompt or force")
+	triageCmd.Flags().Bool("all", false, "Run every non-interactive triage configuration in .gh-pm.yml")
+	triageCmd.Flags().Bool("watch", false, "Keep running, applying rules only to newly matching issues")
+	triageCmd.Flags().Duration("interval", 10*time.Minute, "Time between cycles in watch mode")
+	triageCmd.Flags().Int("max-items", 0, "Triage at most this many issues per run (overrides max_items)")
+	triageCmd.Flags().Int("confirm-over", 0, "Ask for confirmation before triaging more issues than this (overrides confirm_over)")
+	triageCmd.Flags().BoolP("yes", "y", false, "Skip the confirm_over confirmation")
+	triageCmd.Flags().Bool("force", false, "Apply status changes that break the workflow rules in .gh-pm.yml")
+	triageCmd.Flags().String("state-file", "", "Where watch mode records processed issues (default: .gh-pm-state.json next to .gh-pm.yml)")
 	rootCmd.AddCommand(triageCmd)
 }
 
@@ -59,6 +89,11 @@ type TriageCommand struct {
 	issueAPI   *issue.Client
 	searchAPI  *issue.SearchClient
 	urlBuilder *project.URLBuilder
+	useTUI     bool
+	onConflict issue.ConflictPolicy
+	assumeYes  bool
+	force      bool // Override the workflow rules
+	stdin      *bufio.Reader
 }
 
 // IssueUpdate holds the updates to be applied to an issue
@@ -68,6 +103,7 @@ type IssueUpdate struct {
 	StatusChoice   *string           // nil means skip
 	EstimateChoice *string           // nil means skip
 	FieldChoices   map[string]string // field name -> selected value
+	Snapshot       map[string]string // field name -> value when the issue was read
 }
 
 func runTriage(cmd *cobra.Command, args []string) error {
@@ -77,6 +113,39 @@ func runTriage(cmd *cobra.Command, args []string) error {
 	queryFlag, _ := cmd.Flags().GetString("query")
 	applyFlags, _ := cmd.Flags().GetStringSlice("apply")
 	interactiveFields, _ := cmd.Flags().GetStringSlice("interactive")
+	useTUI, _ := cmd.Flags().GetBool("tui")
+	onConflictFlag, _ := cmd.Flags().GetString("on-conflict")
+	runAll, _ := cmd.Flags().GetBool("all")
+	watch, _ := cmd.Flags().GetBool("watch")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	stateFile, _ := cmd.Flags().GetString("state-file")
+	maxItems, _ := cmd.Flags().GetInt("max-items")
+	confirmOver, _ := cmd.Flags().GetInt("confirm-over")
+	assumeYes, _ := cmd.Flags().GetBool("yes")
+	force, _ := cmd.Flags().GetBool("force")
+
+	onConflict, err := issue.ParseConflictPolicy(onConflictFlag)
+	if err != nil {
+		return err
+	}
+
+	if runAll && (len(args) > 0 || queryFlag != "") {
+		return fmt.Errorf("--all cannot be combined with a triage name or --query")
+	}
+	if watch {
+		if dryRun || listOnly {
+			return fmt.Errorf("--watch cannot be combined with --list or --dry-run")
+		}
+		if useTUI || onConflict == issue.ConflictPrompt {
+			return fmt.Errorf("--watch runs unattended and cannot be combined with --tui or --on-conflict=prompt")
+		}
+		if interval <= 0 {
+			return fmt.Errorf("--interval must be positive")
+		}
+	}
+	if maxItems < 0 || confirmOver < 0 {
+		return fmt.Errorf("--max-items and --confirm-over must not be negative")
+	}
 
 	// If either --list or --dry-run is specified, enable list-only mode
 	if dryRun {
@@ -90,6 +159,7 @@ func runTriage(cmd *cobra.Command, args []string) error {
 	}
 
 	var triageConfig config.TriageConfig
+	var triageName string
 
 	// Check if using ad-hoc mode or named configuration
 	if queryFlag != "" {
@@ -99,6 +169,7 @@ func runTriage(cmd *cobra.Command, args []string) error {
 		}
 
 		// Build triage config from flags
+		triageName = "query:" + queryFlag
 		triageConfig = config.TriageConfig{
 			Query: queryFlag,
 			Apply: config.TriageApply{
@@ -111,6 +182,10 @@ func runTriage(cmd *cobra.Command, args []string) error {
 
 		// Parse apply flags
 		for _, apply := range applyFlags {
+			if strings.TrimSpace(apply) == "rollup" {
+				triageConfig.Apply.Rollup = true
+				continue
+			}
 			parts := strings.SplitN(apply, ":", 2)
 			if len(parts) != 2 {
 				return fmt.Errorf("invalid apply format: %s (expected 'field:value')", apply)
@@ -140,14 +215,18 @@ func runTriage(cmd *cobra.Command, args []string) error {
 		}
 	} else if len(args) > 0 {
 		// Named configuration mode
-		triageName := args[0]
+		triageName = args[0]
 		var exists bool
 		triageConfig, exists = cfg.Triage[triageName]
 		if !exists {
 			return fmt.Errorf("triage configuration '%s' not found in .gh-pm.yml", triageName)
 		}
-	} else {
-		return fmt.Errorf("either provide a triage name or use --query with --apply/--interactive")
+	} else if !runAll {
+		return fmt.Errorf("either provide a triage name, use --query with --apply/--interactive, or use --all")
+	}
+
+	if useTUI && !listOnly && !tui.IsTerminal(os.Stdin) {
+		return fmt.Errorf("--tui requires an interactive terminal")
 	}
 
 	// Create clients
@@ -174,9 +253,67 @@ func runTriage(cmd *cobra.Command, args []string) error {
 		issueAPI:   issueClient,
 		searchAPI:  searchClient,
 		urlBuilder: urlBuilder,
+		useTUI:     useTUI,
+		onConflict: onConflict,
+		assumeYes:  assumeYes,
+		force:      force,
+	}
+
+	// Resolve which configurations to run
+	targets := []triageTarget{{Name: triageName, Config: triageConfig}}
+	if runAll {
+		var skipped []string
+		targets, skipped = nonInteractiveTargets(cfg.Triage)
+		if len(skipped) > 0 {
+			fmt.Fprintf(os.Stderr, "Skipping interactive triage configurations: %s\n", strings.Join(skipped, ", "))
+		}
+		if len(targets) == 0 {
+			return fmt.Errorf("no non-interactive triage configurations found in .gh-pm.yml")
+		}
+	} else if watch && triageConfig.IsInteractive() {
+		return fmt.Errorf("--watch requires a non-interactive triage configuration")
+	}
+
+	// Flags take precedence over the limits in .gh-pm.yml
+	for i := range targets {
+		if cmd.Flags().Changed("max-items") {
+			targets[i].Config.MaxItems = maxItems
+		}
+		if cmd.Flags().Changed("confirm-over") {
+			targets[i].Config.ConfirmOver = confirmOver
+		}
+	}
+	triageConfig = targets[0].Config
+
+	if watch {
+		if stateFile == "" {
+			stateFile = config.DefaultStatePath()
+		}
+		state, err := config.LoadTriageState(stateFile)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		return command.Watch(ctx, targets, interval, state, newWatchLogger())
+	}
+
+	if !runAll {
+		return command.Execute(triageConfig, listOnly)
 	}
 
-	return command.Execute(triageConfig, listOnly)
+	for i, target := range targets {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("=== %s ===\n", target.Name)
+		if err := command.Execute(target.Config, listOnly); err != nil {
+			return fmt.Errorf("triage '%s' failed: %w", target.Name, err)
+		}
+	}
+	return nil
 }
 
 func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool) error {
@@ -195,6 +332,11 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 		return nil
 	}
 
+	if capped := capIssues(issues, triageConfig.MaxItems); len(capped) < len(issues) {
+		fmt.Printf("Limiting triage to %d of %d matching issues (max_items: %d)\n", len(capped), len(issues), triageConfig.MaxItems)
+		issues = capped
+	}
+
 	if listOnly {
 		fmt.Printf("Found %d issues that would be affected by triage '%s':\n\n", len(issues), triageConfig.Query)
 		return c.displayIssuesList(issues, triageConfig)
@@ -202,6 +344,100 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 
 	fmt.Printf("Found %d issues to triage\n", len(issues))
 
+	confirmed, err := c.confirmBatch(len(issues), triageConfig.ConfirmOver)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Triage cancelled")
+		return nil
+	}
+
+	result, err := c.apply(triageConfig, issues)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Triage completed for %d issues\n", len(issues))
+
+	// Summarize only when something went wrong, to keep the common case quiet
+	if result.Failed > 0 || len(result.Conflicts) > 0 {
+		fmt.Println()
+		return c.formatter().FormatBatchResult(result.BatchResult)
+	}
+	return nil
+}
+
+// capIssues returns at most maxItems issues; zero means no limit
+func capIssues(issues []filter.GitHubIssue, maxItems int) []filter.GitHubIssue {
+	if maxItems > 0 && len(issues) > maxItems {
+		return issues[:maxItems]
+	}
+	return issues
+}
+
+// needsConfirmation reports whether triaging count issues crosses the confirm_over threshold
+func needsConfirmation(count, confirmOver int) bool {
+	return confirmOver > 0 && count > confirmOver
+}
+
+// confirmBatch asks before triaging more issues than confirmOver. Without a
+// terminal the run is refused unless --yes was given.
+func (c *TriageCommand) confirmBatch(count, confirmOver int) (bool, error) {
+	if !needsConfirmation(count, confirmOver) || c.assumeYes {
+		return true, nil
+	}
+	if !tui.IsTerminal(os.Stdin) {
+		return false, fmt.Errorf("%d issues exceed confirm_over (%d); re-run with --yes to proceed", count, confirmOver)
+	}
+
+	fmt.Printf("This will update %d issues (confirm_over: %d). Continue? [y/N]: ", count, confirmOver)
+	input, _ := c.reader().ReadString('\n')
+	answer := strings.ToLower(strings.TrimSpace(input))
+	return answer == "y" || answer == "yes", nil
+}
+
+// reader returns the shared stdin reader, so prompts never lose buffered input
+func (c *TriageCommand) reader() *bufio.Reader {
+	if c.stdin == nil {
+		c.stdin = bufio.NewReader(os.Stdin)
+	}
+	return c.stdin
+}
+
+// triageResult is a BatchResult that also remembers which issues were
+// handled successfully, for watch mode bookkeeping
+type triageResult struct {
+	*issue.BatchResult
+	Processed []int
+}
+
+func (r *triageResult) recordSuccess(ghIssue filter.GitHubIssue) {
+	r.Total++
+	r.Succeeded++
+	r.Processed = append(r.Processed, ghIssue.Number)
+}
+
+func (r *triageResult) recordFailure(ghIssue filter.GitHubIssue, err error) {
+	r.Total++
+	r.Failed++
+	r.Errors = append(r.Errors, issue.BatchError{
+		Index: r.Total,
+		Title: fmt.Sprintf("#%d %s", ghIssue.Number, ghIssue.Title),
+		Error: err.Error(),
+	})
+}
+
+func (r *triageResult) recordSkipped(ghIssue filter.GitHubIssue) {
+	r.Total++
+	r.Skipped++
+}
+
+// apply adds the issues to the project, collects interactive choices and
+// applies the configured labels and field values
+func (c *TriageCommand) apply(triageConfig config.TriageConfig, issues []filter.GitHubIssue) (*triageResult, error) {
+	var err error
+
 	// Display instruction if configured
 	if triageConfig.Instruction != "" {
 		// Use dim cyan for instruction
@@ -210,7 +446,7 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 
 	// Get project ID if needed for field updates or interactive features
 	var projectID string
-	if len(triageConfig.Apply.Fields) > 0 || triageConfig.Interactive.Status || triageConfig.Interactive.Estimate || len(triageConfig.InteractiveFields) > 0 {
+	if len(triageConfig.Apply.Fields) > 0 || triageConfig.Apply.Rollup || triageConfig.Interactive.Status || triageConfig.Interactive.Estimate || len(triageConfig.InteractiveFields) > 0 {
 		if c.config.Project.Name != "" || c.config.Project.Number > 0 {
 			projectID = c.config.GetProjectID()
 			if projectID == "" {
@@ -232,7 +468,7 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 				}
 
 				if err != nil {
-					return fmt.Errorf("failed to get project: %w", err)
+					return nil, fmt.Errorf("failed to get project: %w", err)
 				}
 				projectID = proj.ID
 				// Cache the project ID for future use
@@ -244,34 +480,9 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 	// Get project fields if we need to update them or handle interactive features
 	var fields []project.Field
 	if projectID != "" && (len(triageConfig.Apply.Fields) > 0 || triageConfig.Interactive.Status || triageConfig.Interactive.Estimate || len(triageConfig.InteractiveFields) > 0) {
-		// Try to use cached fields first
-		if c.config.HasCachedFields() {
-			// Convert cached fields to project.Field format
-			cachedFields := c.config.GetAllFields()
-			fields = make([]project.Field, 0, len(cachedFields))
-			for _, cf := range cachedFields {
-				field := project.Field{
-					ID:       cf.ID,
-					Name:     cf.Name,
-					DataType: cf.DataType,
-				}
-				if cf.Options != nil {
-					field.Options = make([]project.FieldOption, 0, len(cf.Options))
-					for _, opt := range cf.Options {
-						field.Options = append(field.Options, project.FieldOption{
-							ID:   opt.ID,
-							Name: opt.Name,
-						})
-					}
-				}
-				fields = append(fields, field)
-			}
-		} else {
-			// Fallback to API call if no cache
-			fields, err = c.client.GetFieldsWithOptions(projectID)
-			if err != nil {
-				return fmt.Errorf("failed to get project fields: %w", err)
-			}
+		fields, err = c.projectFields(projectID)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -304,25 +515,50 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 		}
 	}
 
+	// Rollups write into the project fields configured under "rollup"
+	var rollup *RollupCommand
+	if triageConfig.Apply.Rollup {
+		rollup, err = newRollupCommand(c.config, c.client, c.issueAPI)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Phase 1: Collect all interactive choices first
 	updates := make([]IssueUpdate, 0, len(issues))
+	result := &triageResult{BatchResult: &issue.BatchResult{}}
+	reader := c.reader()
 
 	hasInteractive := triageConfig.Interactive.Status || triageConfig.Interactive.Estimate || len(triageConfig.InteractiveFields) > 0
-	if hasInteractive {
+	if hasInteractive && c.useTUI {
+		// Full-screen mode: prepare project items first, then collect choices in the UI
+		for _, issue := range issues {
+			update, err := c.prepareUpdate(issue, projectID, fields)
+			if err != nil {
+				result.recordFailure(issue, err)
+				continue
+			}
+			updates = append(updates, update)
+		}
+
+		updates, err = c.runTUI(updates, projectID, fields, triageConfig.Interactive.Status, triageConfig.Interactive.Estimate, triageConfig.InteractiveFields)
+		if err != nil {
+			return nil, err
+		}
+		if len(updates) < len(issues) {
+			fmt.Printf("Triage UI closed early, applying updates to %d reviewed issues\n", len(updates))
+		}
+
+		fmt.Println("\n=== Applying Updates ===")
+	} else if hasInteractive {
 		fmt.Println("\n=== Interactive Selection Phase ===")
-		reader := bufio.NewReader(os.Stdin)
 
 		for _, issue := range issues {
-			update := IssueUpdate{Issue: issue}
-
 			// Get project item ID if needed
-			if projectID != "" {
-				itemID, _, err := c.issueAPI.AddToProjectWithDatabaseID(issue.ID, projectID)
-				if err != nil {
-					fmt.Printf("Warning: failed to add issue #%d to project: %v\n", issue.Number, err)
-					continue
-				}
-				update.ItemID = itemID
+			update, err := c.prepareUpdate(issue, projectID, fields)
+			if err != nil {
+				result.recordFailure(issue, err)
+				continue
 			}
 
 			// Collect interactive status choice
@@ -357,56 +593,90 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 	} else {
 		// No interactive fields, just prepare updates
 		for _, issue := range issues {
-			update := IssueUpdate{Issue: issue}
-
-			if projectID != "" {
-				itemID, _, err := c.issueAPI.AddToProjectWithDatabaseID(issue.ID, projectID)
-				if err != nil {
-					fmt.Printf("Warning: failed to add issue #%d to project: %v\n", issue.Number, err)
-					continue
-				}
-				update.ItemID = itemID
+			update, err := c.prepareUpdate(issue, projectID, fields)
+			if err != nil {
+				result.recordFailure(issue, err)
+				continue
 			}
-
 			updates = append(updates, update)
 		}
 	}
 
 	// Phase 2: Apply all changes
+	resolver := &conflictResolver{policy: c.onConflict, reader: reader, out: os.Stdout}
+	guard := newWorkflowGuard(c.config, c.force, os.Stdout, func() ([]filter.ProjectIssue, error) {
+		return c.searchAPI.FetchProjectIssues(projectID, 0)
+	})
+
 	for _, update := range updates {
 		fmt.Printf("Processing issue #%d: %s\n", update.Issue.Number, update.Issue.Title)
 
+		// Re-read field values and make sure nobody changed them in the meantime
+		if projectID != "" && update.ItemID != "" && update.Snapshot != nil {
+			pending := c.pendingFieldNames(update, triageConfig, fields)
+			if len(pending) > 0 {
+				current, err := c.readFieldValues(projectID, update.Issue.ID, fields)
+				if err != nil {
+					fmt.Printf("Warning: could not re-read fields for issue #%d: %v\n", update.Issue.Number, err)
+				} else {
+					conflicts := issue.DetectConflicts(pending, update.Snapshot, current)
+					resolved, proceed := resolver.resolve(update.Issue.Number, update.Issue.Title, conflicts)
+					result.Conflicts = append(result.Conflicts, resolved...)
+					if !proceed {
+						result.recordSkipped(update.Issue)
+						continue
+					}
+				}
+			}
+		}
+
+		var failures []string
+
 		// Apply labels
 		if len(triageConfig.Apply.Labels) > 0 {
 			if err := c.applyLabels(update.Issue.Number, triageConfig.Apply.Labels); err != nil {
 				fmt.Printf("Warning: failed to apply labels to issue #%d: %v\n", update.Issue.Number, err)
+				failures = append(failures, err.Error())
 			}
 		}
 
 		// Apply project field updates
 		if projectID != "" && update.ItemID != "" {
+			// Status changes must follow the workflow rules
+			currentStatus := update.Snapshot[statusFieldName(c.config)]
+			allowStatus := func(status string) bool {
+				if err := guard.check(update.Issue.Number, currentStatus, status); err != nil {
+					fmt.Printf("Warning: %v\n", err)
+					failures = append(failures, err.Error())
+					return false
+				}
+				return true
+			}
+
 			// Apply configuration fields
 			for fieldKey, fieldValue := range triageConfig.Apply.Fields {
-				var fieldName string
-				switch fieldKey {
-				case "status":
-					fieldName = "Status"
-				case "priority":
-					fieldName = "Priority"
-				default:
-					fieldName = fieldKey
+				fieldName := triageFieldName(c.config, fieldKey)
+				isStatus := fieldKey == "status"
+				if isStatus && !allowStatus(fieldValue) {
+					continue
 				}
 
 				if err := c.updateProjectField(projectID, update.ItemID, fieldName, fieldValue, fields); err != nil {
 					fmt.Printf("Warning: failed to update %s field for issue #%d: %v\n", fieldName, update.Issue.Number, err)
+					failures = append(failures, err.Error())
+				} else if isStatus {
+					guard.record(currentStatus, fieldValue)
+					currentStatus = fieldValue
 				}
 			}
 
 			// Apply interactive status choice
-			if update.StatusChoice != nil {
-				if err := c.updateProjectField(projectID, update.ItemID, "Status", *update.StatusChoice, fields); err != nil {
+			if update.StatusChoice != nil && allowStatus(*update.StatusChoice) {
+				if err := c.updateProjectField(projectID, update.ItemID, statusFieldName(c.config), *update.StatusChoice, fields); err != nil {
 					fmt.Printf("Warning: failed to update status for issue #%d: %v\n", update.Issue.Number, err)
+					failures = append(failures, err.Error())
 				} else {
+					guard.record(currentStatus, *update.StatusChoice)
 					fmt.Printf("✓ Updated status to '%s' for issue #%d\n", *update.StatusChoice, update.Issue.Number)
 				}
 			}
@@ -415,6 +685,7 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 			if update.EstimateChoice != nil {
 				if err := c.updateEstimateField(projectID, update.ItemID, *update.EstimateChoice, fields); err != nil {
 					fmt.Printf("Warning: failed to update estimate for issue #%d: %v\n", update.Issue.Number, err)
+					failures = append(failures, err.Error())
 				} else {
 					fmt.Printf("✓ Set estimate '%s' for issue #%d\n", *update.EstimateChoice, update.Issue.Number)
 				}
@@ -427,15 +698,159 @@ func (c *TriageCommand) Execute(triageConfig config.TriageConfig, listOnly bool)
 				displayFieldName := strings.ToUpper(fieldName[:1]) + fieldName[1:]
 				if err := c.updateProjectField(projectID, update.ItemID, displayFieldName, fieldValue, fields); err != nil {
 					fmt.Printf("Warning: failed to update %s for issue #%d: %v\n", fieldName, update.Issue.Number, err)
+					failures = append(failures, err.Error())
 				} else {
 					fmt.Printf("✓ Updated %s to '%s' for issue #%d\n", fieldName, fieldValue, update.Issue.Number)
 				}
 			}
 		}
+
+		// Roll up sub-issue progress into the issue's own fields
+		if rollup != nil {
+			rolled, err := rollup.Rollup(update.Issue.ID, false)
+			if err != nil {
+				fmt.Printf("Warning: failed to roll up issue #%d: %v\n", update.Issue.Number, err)
+				failures = append(failures, err.Error())
+			} else if rolled.Skipped {
+				fmt.Printf("Warning: issue #%d has no sub-issues; rollup skipped\n", update.Issue.Number)
+			} else {
+				fmt.Printf("✓ Rolled up %s\n", rollup.describe(rolled, false))
+			}
+		}
+
+		if len(failures) > 0 {
+			result.recordFailure(update.Issue, fmt.Errorf("%s", strings.Join(failures, "; ")))
+		} else {
+			result.recordSuccess(update.Issue)
+		}
 	}
 
-	fmt.Printf("Triage completed for %d issues\n", len(issues))
-	return nil
+	return result, nil
+}
+
+// projectFields returns the project fields, preferring the cached metadata
+func (c *TriageCommand) projectFields(projectID string) ([]project.Field, error) {
+	return loadProjectFields(c.config, c.client, projectID)
+}
+
+// loadProjectFields returns the project fields from the cached metadata, or
+// from the API when nothing is cached
+func loadProjectFields(cfg *config.Config, client *project.Client, projectID string) ([]project.Field, error) {
+	// Try to use cached fields first
+	if cfg.HasCachedFields() {
+		// Convert cached fields to project.Field format
+		cachedFields := cfg.GetAllFields()
+		fields := make([]project.Field, 0, len(cachedFields))
+		for _, cf := range cachedFields {
+			field := project.Field{
+				ID:       cf.ID,
+				Name:     cf.Name,
+				DataType: cf.DataType,
+			}
+			if cf.Options != nil {
+				field.Options = make([]project.FieldOption, 0, len(cf.Options))
+				for _, opt := range cf.Options {
+					field.Options = append(field.Options, project.FieldOption{
+						ID:   opt.ID,
+						Name: opt.Name,
+					})
+				}
+			}
+			fields = append(fields, field)
+		}
+		return fields, nil
+	}
+
+	// Fallback to API call if no cache
+	fields, err := client.GetFieldsWithOptions(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project fields: %w", err)
+	}
+	return fields, nil
+}
+
+// prepareUpdate adds the issue to the project (when configured) and records the
+// field values it currently has, so later updates can detect concurrent changes
+func (c *TriageCommand) prepareUpdate(ghIssue filter.GitHubIssue, projectID string, fields []project.Field) (IssueUpdate, error) {
+	update := IssueUpdate{Issue: ghIssue}
+
+	if projectID == "" {
+		return update, nil
+	}
+
+	itemID, _, err := c.issueAPI.AddToProjectWithDatabaseID(ghIssue.ID, projectID)
+	if err != nil {
+		fmt.Printf("Warning: failed to add issue #%d to project: %v\n", ghIssue.Number, err)
+		return update, fmt.Errorf("failed to add issue to project: %w", err)
+	}
+	update.ItemID = itemID
+
+	if len(fields) > 0 {
+		if values, err := c.readFieldValues(projectID, ghIssue.ID, fields); err == nil {
+			update.Snapshot = values
+		}
+	}
+
+	return update, nil
+}
+
+// readFieldValues returns the current project field values of an issue keyed by field name
+func (c *TriageCommand) readFieldValues(projectID, issueID string, fields []project.Field) (map[string]string, error) {
+	itemData, err := c.client.GetProjectItemForIssue(projectID, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return fieldValueNames(fields, itemData.FieldValues), nil
+}
+
+// pendingFieldNames lists the project fields an update is about to change
+func (c *TriageCommand) pendingFieldNames(update IssueUpdate, triageConfig config.TriageConfig, fields []project.Field) []string {
+	var keys []string
+	for fieldKey := range triageConfig.Apply.Fields {
+		keys = append(keys, triageFieldName(c.config, fieldKey))
+	}
+	if update.StatusChoice != nil {
+		keys = append(keys, statusFieldName(c.config))
+	}
+	if update.EstimateChoice != nil {
+		keys = append(keys, "Estimate")
+	}
+	for fieldName := range update.FieldChoices {
+		keys = append(keys, fieldName)
+	}
+
+	var names []string
+	for _, key := range keys {
+		if field := findFieldByName(fields, key); field != nil {
+			names = append(names, field.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// triageFieldName maps a config field key to the project field name
+func triageFieldName(cfg *config.Config, fieldKey string) string {
+	switch fieldKey {
+	case "status":
+		return statusFieldName(cfg)
+	case "priority":
+		return priorityFieldName(cfg)
+	default:
+		return fieldKey
+	}
+}
+
+// formatter returns an output formatter for the global --output flag
+func (c *TriageCommand) formatter() *output.Formatter {
+	switch outputFormat {
+	case "json":
+		return output.NewFormatter(output.FormatJSON)
+	case "csv":
+		return output.NewFormatter(output.FormatCSV)
+	default:
+		return output.NewFormatter(output.FormatTable)
+	}
 }
 
 // Issue search is now handled by the shared SearchClient
@@ -644,37 +1059,7 @@ func (c *TriageCommand) collectStatusChoice(issue filter.GitHubIssue, reader *bu
 	fmt.Printf("\nSelect status for issue #%d: %s\n", issue.Number, issue.Title)
 
 	// Get available status options
-	var availableOptions []string
-	var configMapping map[string]string
-
-	if statusFieldConfig, ok := c.config.Fields["status"]; ok {
-		configMapping = statusFieldConfig.Values
-
-		// If we have config mapping, use the order from field.Options
-		// and only include options that are in the config mapping
-		if len(configMapping) > 0 {
-			// Use the order from statusField.Options
-			for _, option := range statusField.Options {
-				// Check if this option has a reverse mapping in config
-				for key, value := range configMapping {
-					if value == option.Name {
-						availableOptions = append(availableOptions, key)
-						break
-					}
-				}
-			}
-		} else {
-			// No mapping, use field options directly
-			for _, option := range statusField.Options {
-				availableOptions = append(availableOptions, option.Name)
-			}
-		}
-	} else {
-		// No config, use field options directly
-		for _, option := range statusField.Options {
-			availableOptions = append(availableOptions, option.Name)
-		}
-	}
+	availableOptions, configMapping := c.selectOptions("status", statusField)
 
 	// Show options
 	for i, option := range availableOptions {
@@ -751,38 +1136,7 @@ func (c *TriageCommand) collectFieldChoice(issue filter.GitHubIssue, reader *buf
 	switch targetField.DataType {
 	case "SINGLE_SELECT":
 		// Get available options
-		var availableOptions []string
-		var configMapping map[string]string
-
-		// Check if there's a config mapping for this field
-		if fieldConfig, ok := c.config.Fields[strings.ToLower(fieldName)]; ok {
-			configMapping = fieldConfig.Values
-
-			// If we have config mapping, use the order from targetField.Options
-			// and only include options that are in the config mapping
-			if len(configMapping) > 0 {
-				// Use the order from targetField.Options
-				for _, option := range targetField.Options {
-					// Check if this option has a reverse mapping in config
-					for key, value := range configMapping {
-						if value == option.Name {
-							availableOptions = append(availableOptions, key)
-							break
-						}
-					}
-				}
-			} else {
-				// No mapping, use field options directly
-				for _, option := range targetField.Options {
-					availableOptions = append(availableOptions, option.Name)
-				}
-			}
-		} else {
-			// No config, use field options directly
-			for _, option := range targetField.Options {
-				availableOptions = append(availableOptions, option.Name)
-			}
-		}
+		availableOptions, configMapping := c.selectOptions(strings.ToLower(fieldName), targetField)
 
 		// Show options
 		for i, option := range availableOptions {
@@ -842,6 +1196,38 @@ func (c *TriageCommand) collectFieldChoice(issue filter.GitHubIssue, reader *buf
 	}
 }
 
+// selectOptions returns the choices offered for a single select field. When the
+// config maps the field, the config keys are returned in the project's option
+// order (unmapped options are hidden); otherwise the option names are used as-is.
+func (c *TriageCommand) selectOptions(configKey string, field *project.Field) ([]string, map[string]string) {
+	var availableOptions []string
+	var configMapping map[string]string
+
+	if fieldConfig, ok := c.config.Fields[configKey]; ok {
+		configMapping = fieldConfig.Values
+	}
+
+	if len(configMapping) > 0 {
+		// Use the order from field.Options
+		for _, option := range field.Options {
+			// Check if this option has a reverse mapping in config
+			for key, value := range configMapping {
+				if value == option.Name {
+					availableOptions = append(availableOptions, key)
+					break
+				}
+			}
+		}
+	} else {
+		// No mapping, use field options directly
+		for _, option := range field.Options {
+			availableOptions = append(availableOptions, option.Name)
+		}
+	}
+
+	return availableOptions, configMapping
+}
+
 // Project-based search with field filtering is now handled by the shared SearchClient
 
 func (c *TriageCommand) displayIssuesList(issues []filter.GitHubIssue, triageConfig config.TriageConfig) error {
@@ -851,25 +1237,47 @@ func (c *TriageCommand) displayIssuesList(issues []filter.GitHubIssue, triageCon
 		fmt.Printf("\033[36m%s\033[0m\n\n", triageConfig.Instruction)
 	}
 
+	// Load project fields so the preview can show current values
+	projectID := c.config.GetProjectID()
+	var fields []project.Field
+	if projectID != "" {
+		if projectFields, err := c.projectFields(projectID); err == nil {
+			fields = projectFields
+		}
+	}
+
 	// Display issues that would be affected
 	for i, issue := range issues {
 		fmt.Printf("%d. #%d: %s\n", i+1, issue.Number, issue.Title)
 
+		// Current field values; nil means unknown
+		var current map[string]string
+
 		// Try to get project URL
-		projectID := c.config.GetProjectID()
 		if projectID != "" {
 			_, itemDatabaseID, err := c.issueAPI.GetProjectItemID(issue.ID, projectID)
 			if err == nil && itemDatabaseID > 0 {
 				projectURL := c.urlBuilder.GetProjectItemURL(itemDatabaseID)
 				fmt.Printf("   URL: %s\n", projectURL)
+
+				if len(fields) > 0 {
+					if values, err := c.readFieldValues(projectID, issue.ID, fields); err == nil {
+						current = values
+					}
+				}
 			} else {
 				// Issue not in project or error getting item ID
 				fmt.Printf("   URL: %s\n", issue.URL)
+				if err == nil {
+					current = map[string]string{}
+				}
 			}
 		} else {
 			// Fallback to issue URL if no project info
 			fmt.Printf("   URL: %s\n", issue.URL)
 		}
+
+		fmt.Printf("   Changes: %s\n", strings.Join(c.previewChanges(issue, current, triageConfig, fields), ", "))
 	}
 
 	fmt.Printf("\nWould apply the following changes:\n")
@@ -894,6 +1302,10 @@ func (c *TriageCommand) displayIssuesList(issues []filter.GitHubIssue, triageCon
 		}
 	}
 
+	if triageConfig.Apply.Rollup {
+		fmt.Printf("- Rollup: sub-issue progress into the configured rollup fields\n")
+	}
+
 	// Show interactive options
 	if triageConfig.Interactive.Status || triageConfig.Interactive.Estimate || len(triageConfig.InteractiveFields) > 0 {
 		fmt.Printf("- Interactive fields:\n")
@@ -910,7 +1322,7 @@ func (c *TriageCommand) displayIssuesList(issues []filter.GitHubIssue, triageCon
 		}
 	}
 
-	if len(triageConfig.Apply.Labels) == 0 && len(triageConfig.Apply.Fields) == 0 &&
+	if len(triageConfig.Apply.Labels) == 0 && len(triageConfig.Apply.Fields) == 0 && !triageConfig.Apply.Rollup &&
 		!triageConfig.Interactive.Status && !triageConfig.Interactive.Estimate &&
 		len(triageConfig.InteractiveFields) == 0 {
 		fmt.Printf("- No changes configured\n")
@@ -920,3 +1332,86 @@ func (c *TriageCommand) displayIssuesList(issues []filter.GitHubIssue, triageCon
 
 	return nil
 }
+
+// previewChanges describes what triage would change on an issue, such as
+// "Status: Backlog → Ready" or "+label pm-tracked". current holds the issue's
+// field values by name; nil means they are unknown.
+func (c *TriageCommand) previewChanges(ghIssue filter.GitHubIssue, current map[string]string, triageConfig config.TriageConfig, fields []project.Field) []string {
+	var changes []string
+
+	fieldKeys := make([]string, 0, len(triageConfig.Apply.Fields))
+	for fieldKey := range triageConfig.Apply.Fields {
+		fieldKeys = append(fieldKeys, fieldKey)
+	}
+	sort.Strings(fieldKeys)
+
+	for _, fieldKey := range fieldKeys {
+		name := triageFieldName(c.config, fieldKey)
+		if field := findFieldByName(fields, name); field != nil {
+			name = field.Name
+		}
+
+		target := triageConfig.Apply.Fields[fieldKey]
+		if configField, ok := c.config.Fields[fieldKey]; ok {
+			if mapped, ok := configField.Values[target]; ok {
+				target = mapped
+			}
+		}
+
+		if current == nil {
+			changes = append(changes, fmt.Sprintf("%s: ? → %s", name, target))
+		} else if !strings.EqualFold(current[name], target) {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", name, displayValue(current[name]), target))
+		}
+	}
+
+	var prompted []string
+	if triageConfig.Interactive.Status {
+		prompted = append(prompted, "Status")
+	}
+	if triageConfig.Interactive.Estimate {
+		prompted = append(prompted, "Estimate")
+	}
+	var interactiveNames []string
+	for fieldName := range triageConfig.InteractiveFields {
+		interactiveNames = append(interactiveNames, fieldName)
+	}
+	sort.Strings(interactiveNames)
+	prompted = append(prompted, interactiveNames...)
+
+	for _, name := range prompted {
+		if field := findFieldByName(fields, name); field != nil {
+			name = field.Name
+		}
+		value := "?"
+		if current != nil {
+			value = displayValue(current[name])
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s → (prompt)", name, value))
+	}
+
+	for _, label := range triageConfig.Apply.Labels {
+		if !hasLabel(ghIssue.Labels, label) {
+			changes = append(changes, "+label "+label)
+		}
+	}
+
+	if triageConfig.Apply.Rollup {
+		changes = append(changes, "rollup")
+	}
+
+	if len(changes) == 0 {
+		return []string{"no changes"}
+	}
+	return changes
+}
+
+// hasLabel reports whether labels contains name, ignoring case
+func hasLabel(labels []string, name string) bool {
+	for _, label := range labels {
+		if strings.EqualFold(label, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -308,3 +308,113 @@ func TestOptionsOrder_Consistency(t *testing.T) {
 			"Options order should be consistent across multiple iterations (iteration %d)", i+1)
 	}
 }
+
+func TestCapIssues(t *testing.T) {
+	issues := []filter.GitHubIssue{{Number: 1}, {Number: 2}, {Number: 3}}
+
+	assert.Len(t, capIssues(issues, 0), 3, "zero means no limit")
+	assert.Len(t, capIssues(issues, 5), 3)
+	capped := capIssues(issues, 2)
+	assert.Len(t, capped, 2)
+	assert.Equal(t, 1, capped[0].Number)
+}
+
+func TestNeedsConfirmation(t *testing.T) {
+	assert.False(t, needsConfirmation(100, 0), "zero disables confirmation")
+	assert.False(t, needsConfirmation(5, 5))
+	assert.True(t, needsConfirmation(6, 5))
+}
+
+func TestConfirmBatch_AssumeYes(t *testing.T) {
+	cmd := &TriageCommand{assumeYes: true}
+
+	confirmed, err := cmd.confirmBatch(50, 10)
+	assert.NoError(t, err)
+	assert.True(t, confirmed)
+}
+
+func TestPreviewChanges(t *testing.T) {
+	cmd := &TriageCommand{
+		config: &config.Config{
+			Fields: map[string]config.Field{
+				"status": {Field: "Status", Values: map[string]string{"ready": "Ready"}},
+			},
+		},
+	}
+	fields := []project.Field{
+		{Name: "Status", DataType: "SINGLE_SELECT"},
+		{Name: "Priority", DataType: "SINGLE_SELECT"},
+		{Name: "Estimate", DataType: "NUMBER"},
+	}
+	triageConfig := config.TriageConfig{
+		Apply: config.TriageApply{
+			Labels: []string{"pm-tracked", "bug"},
+			Fields: map[string]string{"status": "ready", "priority": "P1"},
+		},
+	}
+	ghIssue := filter.GitHubIssue{Number: 1, Labels: []string{"Bug"}}
+
+	tests := []struct {
+		name     string
+		current  map[string]string
+		config   config.TriageConfig
+		expected []string
+	}{
+		{
+			name:     "changed fields and missing labels",
+			current:  map[string]string{"Status": "Backlog", "Priority": "P1"},
+			config:   triageConfig,
+			expected: []string{"Status: Backlog → Ready", "+label pm-tracked"},
+		},
+		{
+			name:     "not yet in project",
+			current:  map[string]string{},
+			config:   triageConfig,
+			expected: []string{"Priority: - → P1", "Status: - → Ready", "+label pm-tracked"},
+		},
+		{
+			name:     "unknown current values",
+			current:  nil,
+			config:   triageConfig,
+			expected: []string{"Priority: ? → P1", "Status: ? → Ready", "+label pm-tracked"},
+		},
+		{
+			name:     "nothing to change",
+			current:  map[string]string{"Status": "Ready", "Priority": "P1"},
+			config:   config.TriageConfig{Apply: config.TriageApply{Labels: []string{"bug"}, Fields: triageConfig.Apply.Fields}},
+			expected: []string{"no changes"},
+		},
+		{
+			name:    "interactive fields",
+			current: map[string]string{"Status": "Backlog"},
+			config: config.TriageConfig{
+				Interactive:       config.TriageInteractive{Estimate: true},
+				InteractiveFields: map[string]bool{"priority": true},
+			},
+			expected: []string{"Estimate: - → (prompt)", "Priority: - → (prompt)"},
+		},
+		{
+			name:     "rollup action",
+			current:  map[string]string{},
+			config:   config.TriageConfig{Apply: config.TriageApply{Rollup: true}},
+			expected: []string{"rollup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cmd.previewChanges(ghIssue, tt.current, tt.config, fields))
+		})
+	}
+}
+
+func TestTriageFieldName(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status":   {Field: "Stage"},
+		"priority": {Field: "Urgency"},
+	}}
+	assert.Equal(t, "Stage", triageFieldName(cfg, "status"), "the guarded status field follows the configuration")
+	assert.Equal(t, "Urgency", triageFieldName(cfg, "priority"))
+	assert.Equal(t, "Size", triageFieldName(cfg, "Size"))
+	assert.Equal(t, "Status", triageFieldName(&config.Config{}, "status"))
+}
@@ -0,0 +1,522 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+	"github.com/yahsan2/gh-pm/pkg/tui"
+)
+
+// triageShortcuts are the keys assigned to field options, in order.
+// Letters used by session commands (b, o, q, s) are left out.
+const triageShortcuts = "123456789acdefghijklmnprtuvwxyz"
+
+// triageStep is a single field prompt for one issue in the TUI session
+type triageStep struct {
+	index int    // index into the updates slice
+	field string // "status", "estimate" or an interactive field name
+}
+
+// triageIssueDetails holds the data shown in the issue pane
+type triageIssueDetails struct {
+	Body   string
+	Labels []string
+	Values map[string]string // field name -> current display value, nil if not loaded
+	Err    error
+}
+
+// triageTUI is a full-screen session that fills the interactive choices of
+// IssueUpdate values using single-key shortcuts
+type triageTUI struct {
+	command *TriageCommand
+	fields  []project.Field
+	updates []IssueUpdate
+	steps   []triageStep
+	details map[int]*triageIssueDetails
+
+	keys    tui.KeyReader
+	draw    func(lines []string)
+	size    func() (int, int)
+	load    func(update IssueUpdate) *triageIssueDetails
+	openURL func(url string) error
+
+	cursor  int
+	input   string
+	message string
+}
+
+// newTriageTUI builds the list of prompts for the given updates. Status and
+// custom fields are only prompted for issues that have a project item.
+func newTriageTUI(command *TriageCommand, updates []IssueUpdate, fields []project.Field, interactiveStatus, interactiveEstimate bool, interactiveFields map[string]bool) *triageTUI {
+	// Sort custom fields so the prompt order is stable
+	customFields := make([]string, 0, len(interactiveFields))
+	for name := range interactiveFields {
+		if findFieldByName(fields, name) != nil {
+			customFields = append(customFields, name)
+		}
+	}
+	sort.Strings(customFields)
+
+	t := &triageTUI{
+		command: command,
+		fields:  fields,
+		updates: updates,
+		details: make(map[int]*triageIssueDetails),
+	}
+
+	for i, update := range updates {
+		if interactiveStatus && update.ItemID != "" && findFieldByName(fields, "Status") != nil {
+			t.steps = append(t.steps, triageStep{index: i, field: "status"})
+		}
+		if interactiveEstimate {
+			t.steps = append(t.steps, triageStep{index: i, field: "estimate"})
+		}
+		if update.ItemID != "" {
+			for _, name := range customFields {
+				t.steps = append(t.steps, triageStep{index: i, field: name})
+			}
+		}
+	}
+
+	return t
+}
+
+// findFieldByName looks up a project field case-insensitively
+func findFieldByName(fields []project.Field, name string) *project.Field {
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// Run processes key presses until every prompt has been answered or the user
+// quits. It returns the updates for the issues that were reviewed.
+func (t *triageTUI) Run() ([]IssueUpdate, error) {
+	for t.cursor < len(t.steps) {
+		t.render()
+
+		key, err := t.keys.ReadKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key: %w", err)
+		}
+
+		if quit := t.handleKey(key); quit {
+			// Only keep issues whose prompts were all visited
+			reviewed := t.steps[t.cursor].index
+			return t.updates[:reviewed], nil
+		}
+	}
+
+	return t.updates, nil
+}
+
+// handleKey applies a key press to the current prompt and reports whether the
+// session should end
+func (t *triageTUI) handleKey(key tui.Key) bool {
+	step := t.steps[t.cursor]
+	t.message = ""
+
+	// Keys available on every prompt
+	switch key.Code {
+	case tui.KeyCtrlC:
+		return true
+	case tui.KeyCtrlO:
+		t.open(step)
+		return false
+	case tui.KeyTab, tui.KeyRight:
+		t.setChoice(step, nil)
+		t.advance()
+		return false
+	case tui.KeyLeft, tui.KeyEscape:
+		t.back()
+		return false
+	}
+
+	if options := t.optionsFor(step); options != nil {
+		if key.Code != tui.KeyRune {
+			if key.Code == tui.KeyBackspace {
+				t.back()
+			}
+			return false
+		}
+		switch key.Rune {
+		case 'q':
+			return true
+		case 's':
+			t.setChoice(step, nil)
+			t.advance()
+		case 'b':
+			t.back()
+		case 'o':
+			t.open(step)
+		default:
+			idx := strings.IndexRune(triageShortcuts, key.Rune)
+			if idx < 0 || idx >= len(options) {
+				t.message = fmt.Sprintf("No option for key '%c'", key.Rune)
+				return false
+			}
+			value := options[idx]
+			t.setChoice(step, &value)
+			t.advance()
+		}
+		return false
+	}
+
+	// Free-form input for TEXT, NUMBER and estimate prompts
+	switch key.Code {
+	case tui.KeyEnter:
+		value := strings.TrimSpace(t.input)
+		if value == "" {
+			t.setChoice(step, nil)
+		} else {
+			t.setChoice(step, &value)
+		}
+		t.advance()
+	case tui.KeyBackspace:
+		if t.input == "" {
+			t.back()
+		} else {
+			r := []rune(t.input)
+			t.input = string(r[:len(r)-1])
+		}
+	case tui.KeyRune:
+		t.input += string(key.Rune)
+	}
+	return false
+}
+
+// optionsFor returns the single select choices for a step, or nil for free-form prompts
+func (t *triageTUI) optionsFor(step triageStep) []string {
+	if step.field == "estimate" {
+		return nil
+	}
+	field := findFieldByName(t.fields, step.field)
+	if field == nil || field.DataType != "SINGLE_SELECT" {
+		return nil
+	}
+	options, _ := t.command.selectOptions(strings.ToLower(step.field), field)
+	return options
+}
+
+// choiceFor returns the value currently chosen for a step
+func (t *triageTUI) choiceFor(step triageStep) *string {
+	update := t.updates[step.index]
+	switch step.field {
+	case "status":
+		return update.StatusChoice
+	case "estimate":
+		return update.EstimateChoice
+	default:
+		if value, ok := update.FieldChoices[step.field]; ok {
+			return &value
+		}
+		return nil
+	}
+}
+
+func (t *triageTUI) setChoice(step triageStep, value *string) {
+	update := &t.updates[step.index]
+	switch step.field {
+	case "status":
+		update.StatusChoice = value
+	case "estimate":
+		update.EstimateChoice = value
+	default:
+		if update.FieldChoices == nil {
+			update.FieldChoices = make(map[string]string)
+		}
+		if value == nil {
+			delete(update.FieldChoices, step.field)
+		} else {
+			update.FieldChoices[step.field] = *value
+		}
+	}
+}
+
+func (t *triageTUI) advance() {
+	t.cursor++
+	t.resetInput()
+}
+
+func (t *triageTUI) back() {
+	if t.cursor == 0 {
+		t.message = "Already at the first prompt"
+		return
+	}
+	t.cursor--
+	t.resetInput()
+}
+
+// resetInput pre-fills the text buffer with the choice already made for the current step
+func (t *triageTUI) resetInput() {
+	t.input = ""
+	if t.cursor < len(t.steps) {
+		if choice := t.choiceFor(t.steps[t.cursor]); choice != nil {
+			t.input = *choice
+		}
+	}
+}
+
+func (t *triageTUI) open(step triageStep) {
+	url := t.updates[step.index].Issue.URL
+	if t.openURL == nil || url == "" {
+		t.message = "No URL available for this issue"
+		return
+	}
+	if err := t.openURL(url); err != nil {
+		t.message = fmt.Sprintf("Failed to open browser: %v", err)
+		return
+	}
+	t.message = "Opened " + url
+}
+
+// issueDetails returns the details for the issue at index, loading them on first use
+func (t *triageTUI) issueDetails(index int) *triageIssueDetails {
+	if d, ok := t.details[index]; ok {
+		return d
+	}
+	d := &triageIssueDetails{}
+	if t.load != nil {
+		d = t.load(t.updates[index])
+	}
+	t.details[index] = d
+
+	// What the user sees is what later conflict checks compare against
+	if d.Values != nil {
+		t.updates[index].Snapshot = d.Values
+	}
+	return d
+}
+
+func (t *triageTUI) render() {
+	width, height := 100, 30
+	if t.size != nil {
+		width, height = t.size()
+	}
+	t.draw(t.renderLines(width, height))
+}
+
+// renderLines lays out the header, the issue pane, the choice pane and the footer
+func (t *triageTUI) renderLines(width, height int) []string {
+	step := t.steps[t.cursor]
+	update := t.updates[step.index]
+
+	// Count prompts for this issue to show progress
+	issuePrompt, issuePrompts := 0, 0
+	for i, s := range t.steps {
+		if s.index == step.index {
+			issuePrompts++
+			if i <= t.cursor {
+				issuePrompt++
+			}
+		}
+	}
+
+	header := fmt.Sprintf("%sgh pm triage%s  Issue %d/%d  Field %d/%d",
+		tui.Bold, tui.Reset, step.index+1, len(t.updates), issuePrompt, issuePrompts)
+	lines := []string{header, tui.Dim + strings.Repeat("─", width) + tui.Reset}
+
+	bodyHeight := height - 4
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+
+	rightWidth := width / 3
+	if rightWidth < 28 {
+		rightWidth = 28
+	}
+	leftWidth := width - rightWidth - 3
+	if leftWidth < 20 {
+		leftWidth = 20
+	}
+
+	left := t.renderIssuePane(update, t.issueDetails(step.index), leftWidth)
+	if len(left) > bodyHeight {
+		left = append(left[:bodyHeight-1], tui.Dim+"…"+tui.Reset)
+	}
+	right := t.renderChoicePane(step, rightWidth)
+
+	lines = append(lines, tui.Columns(left, right, leftWidth, bodyHeight)...)
+	lines = append(lines, tui.Dim+strings.Repeat("─", width)+tui.Reset)
+	lines = append(lines, tui.Truncate(t.message, width))
+	return lines
+}
+
+func (t *triageTUI) renderIssuePane(update IssueUpdate, details *triageIssueDetails, width int) []string {
+	var lines []string
+	for _, l := range tui.Wrap(fmt.Sprintf("#%d %s", update.Issue.Number, update.Issue.Title), width) {
+		lines = append(lines, tui.Bold+l+tui.Reset)
+	}
+	if update.Issue.URL != "" {
+		lines = append(lines, tui.Dim+tui.Truncate(update.Issue.URL, width)+tui.Reset)
+	}
+	lines = append(lines, "")
+
+	if details.Err != nil {
+		lines = append(lines, tui.Wrap(fmt.Sprintf("Could not load issue details: %v", details.Err), width)...)
+		return lines
+	}
+
+	labels := "-"
+	if len(details.Labels) > 0 {
+		labels = strings.Join(details.Labels, ", ")
+	}
+	lines = append(lines, tui.Wrap("Labels: "+labels, width)...)
+
+	// Show current field values in project field order
+	for _, field := range t.fields {
+		if value, ok := details.Values[field.Name]; ok && value != "" {
+			lines = append(lines, tui.Truncate(fmt.Sprintf("%s: %s", field.Name, value), width))
+		}
+	}
+	lines = append(lines, "")
+
+	if strings.TrimSpace(details.Body) == "" {
+		lines = append(lines, tui.Dim+"No description provided."+tui.Reset)
+	} else {
+		lines = append(lines, tui.RenderMarkdown(details.Body, width)...)
+	}
+	return lines
+}
+
+func (t *triageTUI) renderChoicePane(step triageStep, width int) []string {
+	fieldName := step.field
+	if field := findFieldByName(t.fields, step.field); field != nil {
+		fieldName = field.Name
+	} else if step.field == "estimate" {
+		fieldName = "Estimate"
+	}
+
+	lines := []string{tui.Bold + tui.Cyan + fieldName + tui.Reset}
+	if current, ok := t.issueDetails(step.index).Values[fieldName]; ok && current != "" {
+		lines = append(lines, tui.Dim+tui.Truncate("Current: "+current, width)+tui.Reset)
+	}
+	lines = append(lines, "")
+
+	chosen := t.choiceFor(step)
+	if options := t.optionsFor(step); options != nil {
+		var mapping map[string]string
+		if field := findFieldByName(t.fields, step.field); field != nil {
+			_, mapping = t.command.selectOptions(strings.ToLower(step.field), field)
+		}
+		for i, option := range options {
+			key := "  "
+			if i < len(triageShortcuts) {
+				key = string(triageShortcuts[i]) + " "
+			}
+			display := option
+			if mapped, ok := mapping[option]; ok && mapped != option {
+				display = fmt.Sprintf("%s (%s)", option, mapped)
+			}
+			line := tui.Truncate(fmt.Sprintf("[%s] %s", strings.TrimSpace(key), display), width-2)
+			if chosen != nil && *chosen == option {
+				line = tui.Green + line + " ✓" + tui.Reset
+			}
+			lines = append(lines, line)
+		}
+		lines = append(lines, "",
+			tui.Dim+"s/Tab skip  b/← back"+tui.Reset,
+			tui.Dim+"o open in browser  q quit"+tui.Reset)
+		return lines
+	}
+
+	hint := "Type a value"
+	if step.field == "estimate" {
+		hint = "e.g. 2h, 1d, 3pts"
+	}
+	lines = append(lines,
+		tui.Dim+hint+tui.Reset,
+		"> "+t.input+"█",
+		"",
+		tui.Dim+"Enter accept (empty skips)"+tui.Reset,
+		tui.Dim+"Tab skip  Esc/← back"+tui.Reset,
+		tui.Dim+"Ctrl+O open  Ctrl+C quit"+tui.Reset)
+	return lines
+}
+
+// runTUI opens the terminal in full-screen mode and collects interactive
+// choices for the prepared updates
+func (c *TriageCommand) runTUI(updates []IssueUpdate, projectID string, fields []project.Field, interactiveStatus, interactiveEstimate bool, interactiveFields map[string]bool) ([]IssueUpdate, error) {
+	session := newTriageTUI(c, updates, fields, interactiveStatus, interactiveEstimate, interactiveFields)
+	if len(session.steps) == 0 {
+		return updates, nil
+	}
+
+	screen, err := tui.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start triage UI: %w", err)
+	}
+	defer screen.Close()
+
+	session.keys = screen
+	session.draw = screen.Draw
+	session.size = screen.Size
+	session.openURL = openURL
+	session.load = func(update IssueUpdate) *triageIssueDetails {
+		return c.loadIssueDetails(update, projectID, fields)
+	}
+
+	return session.Run()
+}
+
+// loadIssueDetails fetches the body, labels and current project field values of an issue
+func (c *TriageCommand) loadIssueDetails(update IssueUpdate, projectID string, fields []project.Field) *triageIssueDetails {
+	var repo string
+	if len(c.config.Repositories) > 0 {
+		repo = c.config.Repositories[0]
+	}
+
+	details := &triageIssueDetails{}
+
+	issueData, err := issue.GetIssueDetails(update.Issue.Number, repo)
+	if err != nil {
+		details.Err = err
+		return details
+	}
+	details.Body = issueData.Body
+	for _, label := range issueData.Labels {
+		details.Labels = append(details.Labels, label.Name)
+	}
+
+	if projectID == "" || update.ItemID == "" {
+		return details
+	}
+
+	itemData, err := c.client.GetProjectItemForIssue(projectID, update.Issue.ID)
+	if err != nil {
+		// Field values are informational only
+		return details
+	}
+	details.Values = fieldValueNames(fields, itemData.FieldValues)
+	return details
+}
+
+// fieldValueNames converts project item field values keyed by field ID into
+// display values keyed by field name, resolving single select option names
+func fieldValueNames(fields []project.Field, values map[string]interface{}) map[string]string {
+	names := make(map[string]string)
+	for _, field := range fields {
+		value, ok := values[field.ID]
+		if !ok {
+			continue
+		}
+		if field.DataType == "SINGLE_SELECT" {
+			if optionID, ok := value.(string); ok {
+				for _, option := range field.Options {
+					if option.ID == optionID {
+						names[field.Name] = option.Name
+						break
+					}
+				}
+			}
+			continue
+		}
+		names[field.Name] = fmt.Sprintf("%v", value)
+	}
+	return names
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/project"
+	"github.com/yahsan2/gh-pm/pkg/tui"
+)
+
+// scriptedKeys replays a fixed sequence of key presses
+type scriptedKeys struct {
+	keys []tui.Key
+}
+
+func (s *scriptedKeys) ReadKey() (tui.Key, error) {
+	if len(s.keys) == 0 {
+		return tui.Key{}, fmt.Errorf("no more keys")
+	}
+	key := s.keys[0]
+	s.keys = s.keys[1:]
+	return key, nil
+}
+
+func runes(s string) []tui.Key {
+	keys := make([]tui.Key, 0, len(s))
+	for _, r := range s {
+		keys = append(keys, tui.Key{Code: tui.KeyRune, Rune: r})
+	}
+	return keys
+}
+
+func newTestTriageTUI(keys []tui.Key, interactiveFields map[string]bool) (*triageTUI, *[]string) {
+	command := &TriageCommand{
+		config: &config.Config{
+			Fields: map[string]config.Field{
+				"status": {
+					Field: "Status",
+					Values: map[string]string{
+						"backlog": "Backlog",
+						"ready":   "Ready",
+					},
+				},
+			},
+		},
+	}
+	fields := []project.Field{
+		{
+			ID:       "status-id",
+			Name:     "Status",
+			DataType: "SINGLE_SELECT",
+			Options: []project.FieldOption{
+				{ID: "1", Name: "Backlog"},
+				{ID: "2", Name: "Ready"},
+			},
+		},
+		{ID: "points-id", Name: "Points", DataType: "NUMBER"},
+	}
+	updates := []IssueUpdate{
+		{Issue: filter.GitHubIssue{Number: 1, Title: "First"}, ItemID: "item-1"},
+		{Issue: filter.GitHubIssue{Number: 2, Title: "Second"}, ItemID: "item-2"},
+	}
+
+	session := newTriageTUI(command, updates, fields, true, false, interactiveFields)
+	var screen []string
+	session.keys = &scriptedKeys{keys: keys}
+	session.draw = func(lines []string) { screen = lines }
+	session.load = func(update IssueUpdate) *triageIssueDetails {
+		return &triageIssueDetails{
+			Body:   "Body of " + update.Issue.Title,
+			Labels: []string{"bug"},
+			Values: map[string]string{"Status": "Backlog"},
+		}
+	}
+	return session, &screen
+}
+
+func TestTriageTUI_SelectSkipAndBack(t *testing.T) {
+	keys := runes("1")                             // issue 1: backlog
+	keys = append(keys, runes("b")...)             // back to issue 1
+	keys = append(keys, runes("2")...)             // issue 1: ready
+	keys = append(keys, tui.Key{Code: tui.KeyTab}) // issue 2: skip
+	session, _ := newTestTriageTUI(keys, nil)
+
+	updates, err := session.Run()
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	require.NotNil(t, updates[0].StatusChoice)
+	assert.Equal(t, "ready", *updates[0].StatusChoice)
+	assert.Nil(t, updates[1].StatusChoice)
+}
+
+func TestTriageTUI_TextInputAndQuit(t *testing.T) {
+	keys := runes("2")                                   // issue 1 status: ready
+	keys = append(keys, runes("35")...)                  // issue 1 points: type "35"
+	keys = append(keys, tui.Key{Code: tui.KeyBackspace}) // "3"
+	keys = append(keys, tui.Key{Code: tui.KeyEnter})     // accept
+	keys = append(keys, runes("q")...)                   // quit on issue 2
+	session, _ := newTestTriageTUI(keys, map[string]bool{"points": true})
+
+	updates, err := session.Run()
+	require.NoError(t, err)
+	require.Len(t, updates, 1, "only the fully reviewed issue is returned")
+
+	require.NotNil(t, updates[0].StatusChoice)
+	assert.Equal(t, "ready", *updates[0].StatusChoice)
+	assert.Equal(t, map[string]string{"points": "3"}, updates[0].FieldChoices)
+}
+
+func TestTriageTUI_UnknownShortcut(t *testing.T) {
+	keys := runes("9")
+	keys = append(keys, runes("1")...)
+	keys = append(keys, runes("1")...)
+	session, screen := newTestTriageTUI(keys, nil)
+
+	// Render the first prompt to check the layout
+	session.render()
+	plain := tui.StripANSI(strings.Join(*screen, "\n"))
+	assert.Contains(t, plain, "#1 First")
+	assert.Contains(t, plain, "Labels: bug")
+	assert.Contains(t, plain, "Current: Backlog")
+	assert.Contains(t, plain, "[1] backlog (Backlog)")
+	assert.Contains(t, plain, "[2] ready (Ready)")
+
+	_, err := session.Run()
+	require.NoError(t, err)
+	assert.Equal(t, "", session.message)
+}
+
+func TestFieldValueNames(t *testing.T) {
+	fields := []project.Field{
+		{ID: "s", Name: "Status", DataType: "SINGLE_SELECT", Options: []project.FieldOption{{ID: "o1", Name: "Ready"}}},
+		{ID: "e", Name: "Estimate", DataType: "NUMBER"},
+		{ID: "t", Name: "Notes", DataType: "TEXT"},
+	}
+	values := map[string]interface{}{"s": "o1", "e": float64(3), "x": "ignored"}
+
+	assert.Equal(t, map[string]string{"Status": "Ready", "Estimate": "3"}, fieldValueNames(fields, values))
+}
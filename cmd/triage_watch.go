@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+// triageTarget is a named triage configuration to run
+type triageTarget struct {
+	Name   string
+	Config config.TriageConfig
+}
+
+// nonInteractiveTargets returns every triage configuration that can run
+// unattended, sorted by name, along with the names that were left out
+func nonInteractiveTargets(triages map[string]config.TriageConfig) ([]triageTarget, []string) {
+	names := make([]string, 0, len(triages))
+	for name := range triages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var targets []triageTarget
+	var skipped []string
+	for _, name := range names {
+		if triages[name].IsInteractive() {
+			skipped = append(skipped, name)
+			continue
+		}
+		targets = append(targets, triageTarget{Name: name, Config: triages[name]})
+	}
+	return targets, skipped
+}
+
+// filterUnprocessed drops issues the triage configuration already handled
+func filterUnprocessed(issues []filter.GitHubIssue, state *config.TriageState, triageName string) []filter.GitHubIssue {
+	var fresh []filter.GitHubIssue
+	for _, issue := range issues {
+		if !state.IsProcessed(triageName, issue.Number) {
+			fresh = append(fresh, issue)
+		}
+	}
+	return fresh
+}
+
+// Watch runs the triage targets every interval until the context is cancelled,
+// applying each configuration only to issues it has not processed before
+func (c *TriageCommand) Watch(ctx context.Context, targets []triageTarget, interval time.Duration, state *config.TriageState, logger *slog.Logger) error {
+	logger.Info("triage watch started", "triages", len(targets), "interval", interval.String())
+
+	for cycle := 1; ; cycle++ {
+		for _, target := range targets {
+			if ctx.Err() != nil {
+				break
+			}
+			c.runWatchCycle(cycle, target, state, logger)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("triage watch stopped", "cycles", cycle)
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// runWatchCycle runs one triage configuration once and logs a summary
+func (c *TriageCommand) runWatchCycle(cycle int, target triageTarget, state *config.TriageState, logger *slog.Logger) {
+	started := time.Now()
+	log := logger.With("cycle", cycle, "triage", target.Name)
+
+	filters := filter.NewIssueFilters()
+	filters.Search = target.Config.Query
+
+	issues, err := c.searchAPI.SearchIssues(filters)
+	if err != nil {
+		log.Error("triage search failed", "error", err.Error())
+		return
+	}
+
+	fresh := filterUnprocessed(issues, state, target.Name)
+	if len(fresh) == 0 {
+		log.Info("triage cycle complete", "matched", len(issues), "new", 0, "duration", time.Since(started).Round(time.Millisecond).String())
+		return
+	}
+
+	if capped := capIssues(fresh, target.Config.MaxItems); len(capped) < len(fresh) {
+		log.Info("triage capped", "new", len(fresh), "max_items", target.Config.MaxItems)
+		fresh = capped
+	}
+	if needsConfirmation(len(fresh), target.Config.ConfirmOver) && !c.assumeYes {
+		log.Warn("triage needs confirmation, skipping cycle", "new", len(fresh), "confirm_over", target.Config.ConfirmOver)
+		return
+	}
+
+	result, err := c.apply(target.Config, fresh)
+	if err != nil {
+		log.Error("triage failed", "matched", len(issues), "new", len(fresh), "error", err.Error())
+		return
+	}
+
+	now := time.Now()
+	for _, number := range result.Processed {
+		state.MarkProcessed(target.Name, number, now)
+	}
+	if err := state.Save(); err != nil {
+		log.Error("failed to save triage state", "error", err.Error())
+	}
+
+	log.Info("triage cycle complete",
+		"matched", len(issues),
+		"new", len(fresh),
+		"succeeded", result.Succeeded,
+		"failed", result.Failed,
+		"skipped", result.Skipped,
+		"conflicts", len(result.Conflicts),
+		"duration", time.Since(started).Round(time.Millisecond).String(),
+	)
+}
+
+// newWatchLogger returns a structured logger writing to stderr, as JSON when
+// --output json is requested
+func newWatchLogger() *slog.Logger {
+	if outputFormat == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, nil))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, nil))
+}
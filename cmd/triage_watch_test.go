@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+func TestNonInteractiveTargets(t *testing.T) {
+	triages := map[string]config.TriageConfig{
+		"tracked": {
+			Query: "is:open -label:pm-tracked",
+			Apply: config.TriageApply{Labels: []string{"pm-tracked"}},
+		},
+		"estimate": {
+			Query:       "is:open -has:estimate",
+			Interactive: config.TriageInteractive{Estimate: true},
+		},
+		"bugs": {
+			Query: "is:open label:bug",
+			Apply: config.TriageApply{Fields: map[string]string{"priority": "high"}},
+		},
+	}
+
+	targets, skipped := nonInteractiveTargets(triages)
+
+	require.Len(t, targets, 2)
+	assert.Equal(t, "bugs", targets[0].Name)
+	assert.Equal(t, "tracked", targets[1].Name)
+	assert.Equal(t, "is:open label:bug", targets[0].Config.Query)
+	assert.Equal(t, []string{"estimate"}, skipped)
+}
+
+func TestFilterUnprocessed(t *testing.T) {
+	state, err := config.LoadTriageState(filepath.Join(t.TempDir(), config.StateFileName))
+	require.NoError(t, err)
+	state.MarkProcessed("tracked", 1, time.Now())
+	state.MarkProcessed("bugs", 2, time.Now())
+
+	issues := []filter.GitHubIssue{{Number: 1}, {Number: 2}, {Number: 3}}
+
+	fresh := filterUnprocessed(issues, state, "tracked")
+
+	require.Len(t, fresh, 2)
+	assert.Equal(t, 2, fresh[0].Number)
+	assert.Equal(t, 3, fresh[1].Number)
+}
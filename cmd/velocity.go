@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var velocityLast int
+
+// velocityCmd reports the completed estimate of past iterations
+var velocityCmd = &cobra.Command{
+	Use:   "velocity",
+	Short: "Show the velocity of the last completed iterations",
+	Long: `Show, for each of the last completed iterations, the estimate committed to it
+and the estimate completed within it, with the average and the trend.
+
+Committed is the estimate of the items in the iteration, plus the items that
+'gh pm sprint rollover' moved out of it unfinished, read from the comment
+rollover leaves on each item. Completed counts the items that were closed or
+moved into the "done" status between the start and the end of the iteration.
+GitHub does not record other changes to the iteration field, so unfinished
+items moved to a later iteration by hand count only towards that iteration.`,
+	Example: `  # The last 6 completed iterations
+  gh pm velocity --last 6
+
+  # As JSON or CSV
+  gh pm velocity --output json
+  gh pm velocity --output csv`,
+	Args: cobra.NoArgs,
+	RunE: runVelocity,
+}
+
+func init() {
+	rootCmd.AddCommand(velocityCmd)
+	velocityCmd.Flags().IntVar(&velocityLast, "last", 6, "Number of completed iterations to include")
+	addIterationFlags(velocityCmd)
+}
+
+// velocityIteration is the committed and completed work of one iteration
+type velocityIteration struct {
+	Title          string  `json:"title"`
+	StartDate      string  `json:"start_date"`
+	EndDate        string  `json:"end_date"`
+	Items          int     `json:"items"`
+	CompletedItems int     `json:"completed_items"`
+	RolledOver     int     `json:"rolled_over"`
+	Committed      float64 `json:"committed"`
+	Completed      float64 `json:"completed"`
+}
+
+// velocityReport is the velocity over several iterations
+type velocityReport struct {
+	Iterations []velocityIteration `json:"iterations"`
+	Average    float64             `json:"average"`
+	// Trend is the change in completed estimate per iteration
+	Trend float64 `json:"trend"`
+}
+
+func runVelocity(cmd *cobra.Command, args []string) error {
+	if velocityLast < 1 {
+		return fmt.Errorf("--last must be at least 1")
+	}
+
+	command, err := newSprintCommand()
+	if err != nil {
+		return err
+	}
+
+	// Items rolled over out of an iteration have moved to another one, so read
+	// the history of every item with an iteration
+	all := fetchHistories(command.issueAPI, command.items, func(item filter.ProjectIssue) bool {
+		return item.Fields[command.field.Name] != nil
+	})
+	histories := make(map[string][]issue.HistoryEvent, len(command.items))
+	for i, item := range command.items {
+		histories[item.ID] = all[i]
+	}
+
+	var iterations []velocityIteration
+	for _, iteration := range completedIterations(command.field, command.now, velocityLast) {
+		items := command.itemsIn(iteration)
+		finished := make([]*time.Time, len(items))
+		for i, item := range items {
+			finished[i] = itemFinishedAt(command.config, item, histories[item.ID], command.projectID)
+		}
+		rolledOver := rolledOverFrom(iteration, command.items, histories)
+		iterations = append(iterations, measureVelocity(command.config, iteration, items, finished, rolledOver))
+	}
+	report := buildVelocityReport(iterations)
+
+	switch outputFormat {
+	case "json":
+		return output.NewFormatter(output.FormatJSON).Format(report)
+	case "csv":
+		return writeVelocityCSV(report)
+	default:
+		return printVelocityReport(command.field.Name, report)
+	}
+}
+
+// completedIterations returns the last n iterations that have ended, oldest first
+func completedIterations(field *project.IterationField, now time.Time, n int) []project.Iteration {
+	var ended []project.Iteration
+	for _, iteration := range field.Iterations {
+		if iteration.Completed || !iteration.End().After(now) {
+			ended = append(ended, iteration)
+		}
+	}
+	if len(ended) > n {
+		ended = ended[len(ended)-n:]
+	}
+	return ended
+}
+
+// measureVelocity sums the committed estimate of the items, including those
+// rolled over out of the iteration, and the estimate of those finished within it
+func measureVelocity(cfg *config.Config, iteration project.Iteration, items []filter.ProjectIssue, finished []*time.Time, rolledOver []filter.ProjectIssue) velocityIteration {
+	result := velocityIteration{
+		Title:     iteration.Title,
+		StartDate: iteration.StartDate,
+		EndDate:   iteration.End().AddDate(0, 0, -1).Format("2006-01-02"),
+		Items:     len(items),
+	}
+	for i, item := range items {
+		estimate := 0.0
+		if value := itemEstimate(cfg, item); value != nil {
+			estimate = *value
+		}
+		result.Committed += estimate
+		if finished[i] != nil && iteration.Contains(*finished[i]) {
+			result.CompletedItems++
+			result.Completed += estimate
+		}
+	}
+	for _, item := range rolledOver {
+		if containsItem(items, item.Number) {
+			// Moved back into the iteration, already counted above
+			continue
+		}
+		result.Items++
+		result.RolledOver++
+		if value := itemEstimate(cfg, item); value != nil {
+			result.Committed += *value
+		}
+	}
+	return result
+}
+
+// rolledOverFrom returns the items whose history shows sprint rollover moving
+// them out of the iteration
+func rolledOverFrom(iteration project.Iteration, items []filter.ProjectIssue, histories map[string][]issue.HistoryEvent) []filter.ProjectIssue {
+	var rolled []filter.ProjectIssue
+	for _, item := range items {
+		for _, event := range histories[item.ID] {
+			if event.Type == issue.HistoryRolledOver && event.IterationID == iteration.ID {
+				rolled = append(rolled, item)
+				break
+			}
+		}
+	}
+	return rolled
+}
+
+// containsItem reports whether an item with the issue number is in items
+func containsItem(items []filter.ProjectIssue, number int) bool {
+	for _, item := range items {
+		if item.Number == number {
+			return true
+		}
+	}
+	return false
+}
+
+// buildVelocityReport computes the average completed estimate and its trend,
+// the least-squares slope over the iterations in order
+func buildVelocityReport(iterations []velocityIteration) velocityReport {
+	report := velocityReport{Iterations: iterations}
+	if report.Iterations == nil {
+		report.Iterations = []velocityIteration{}
+	}
+	if len(iterations) == 0 {
+		return report
+	}
+
+	var sum float64
+	for _, iteration := range iterations {
+		sum += iteration.Completed
+	}
+	report.Average = math.Round(sum/float64(len(iterations))*10) / 10
+
+	n := float64(len(iterations))
+	meanX := (n - 1) / 2
+	meanY := sum / n
+	var num, den float64
+	for i, iteration := range iterations {
+		num += (float64(i) - meanX) * (iteration.Completed - meanY)
+		den += (float64(i) - meanX) * (float64(i) - meanX)
+	}
+	if den > 0 {
+		report.Trend = math.Round(num/den*10) / 10
+	}
+	return report
+}
+
+// completionRate formats completed as a percentage of committed
+func completionRate(iteration velocityIteration) string {
+	if iteration.Committed == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", iteration.Completed/iteration.Committed*100)
+}
+
+func printVelocityReport(fieldName string, report velocityReport) error {
+	if len(report.Iterations) == 0 {
+		fmt.Printf("'%s' has no completed iterations\n", fieldName)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ITERATION\tSTART\tEND\tITEMS\tCOMMITTED\tCOMPLETED\tRATE\n")
+	for _, iteration := range report.Iterations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\t%s\n", iteration.Title, iteration.StartDate, iteration.EndDate,
+			iteration.CompletedItems, iteration.Items, formatNumber(iteration.Committed),
+			formatNumber(iteration.Completed), completionRate(iteration))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	trend := "steady"
+	switch {
+	case report.Trend > 0:
+		trend = fmt.Sprintf("rising, +%s per iteration", formatNumber(report.Trend))
+	case report.Trend < 0:
+		trend = fmt.Sprintf("falling, %s per iteration", formatNumber(report.Trend))
+	}
+	fmt.Printf("\nAverage velocity: %s (%s)\n", formatNumber(report.Average), trend)
+	return nil
+}
+
+func writeVelocityCSV(report velocityReport) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"Iteration", "Start", "End", "Items", "Completed items", "Rolled over", "Committed", "Completed"})
+	for _, iteration := range report.Iterations {
+		_ = w.Write([]string{
+			iteration.Title,
+			iteration.StartDate,
+			iteration.EndDate,
+			strconv.Itoa(iteration.Items),
+			strconv.Itoa(iteration.CompletedItems),
+			strconv.Itoa(iteration.RolledOver),
+			formatNumber(iteration.Committed),
+			formatNumber(iteration.Completed),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
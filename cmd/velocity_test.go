@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+func TestCompletedIterations(t *testing.T) {
+	field := &project.IterationField{Iterations: []project.Iteration{
+		{Title: "Sprint 1", StartDate: "2026-02-02", Duration: 14, Completed: true},
+		{Title: "Sprint 2", StartDate: "2026-02-16", Duration: 14, Completed: true},
+		{Title: "Sprint 3", StartDate: "2026-03-02", Duration: 14},
+		{Title: "Sprint 4", StartDate: "2026-03-16", Duration: 14},
+		{Title: "Sprint 5", StartDate: "2026-03-30", Duration: 14},
+	}}
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.Local)
+
+	titles := func(iterations []project.Iteration) []string {
+		var result []string
+		for _, iteration := range iterations {
+			result = append(result, iteration.Title)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"Sprint 1", "Sprint 2", "Sprint 3"}, titles(completedIterations(field, now, 6)),
+		"ended iterations count even when not yet marked completed")
+	assert.Equal(t, []string{"Sprint 2", "Sprint 3"}, titles(completedIterations(field, now, 2)))
+}
+
+func TestMeasureVelocity(t *testing.T) {
+	cfg := sprintTestConfig()
+	iteration := project.Iteration{Title: "Sprint 3", StartDate: "2026-03-02", Duration: 14}
+	at := func(day int) *time.Time {
+		value := iteration.Start().AddDate(0, 0, day)
+		return &value
+	}
+
+	items := []filter.ProjectIssue{
+		{Number: 1, Fields: map[string]interface{}{"Points": 3.0}},
+		{Number: 2, Fields: map[string]interface{}{"Points": 5.0}},
+		{Number: 3, Fields: map[string]interface{}{"Points": 2.0}},
+		{Number: 4, Fields: map[string]interface{}{}},
+		{Number: 5, Fields: map[string]interface{}{"Points": 1.0}},
+	}
+	finished := []*time.Time{at(3), at(14), nil, at(5), at(-1)}
+
+	result := measureVelocity(cfg, iteration, items, finished, nil)
+	assert.Equal(t, 5, result.Items)
+	assert.Equal(t, 2, result.CompletedItems, "items finished after the end or before the start are not completed")
+	assert.Equal(t, 11.0, result.Committed)
+	assert.Equal(t, 3.0, result.Completed)
+	assert.Equal(t, "2026-03-15", result.EndDate)
+}
+
+func TestMeasureVelocityAfterRollover(t *testing.T) {
+	cfg := sprintTestConfig()
+	iteration := project.Iteration{ID: "IT_3", Title: "Sprint 3", StartDate: "2026-03-02", Duration: 14}
+	done := iteration.Start().AddDate(0, 0, 4)
+
+	// Rollover moved the unfinished #2 and #3 on, leaving only the finished item
+	items := []filter.ProjectIssue{{Number: 1, Fields: map[string]interface{}{"Points": 3.0}}}
+	finished := []*time.Time{&done}
+
+	unrecorded := measureVelocity(cfg, iteration, items, finished, nil)
+	assert.Equal(t, unrecorded.Committed, unrecorded.Completed, "without the record, the rate is always 100%")
+	assert.Equal(t, "100%", completionRate(unrecorded))
+
+	rolled := []filter.ProjectIssue{
+		items[0], // Moved back into the iteration
+		{Number: 2, Fields: map[string]interface{}{"Points": 5.0}},
+		{Number: 3, Fields: map[string]interface{}{"Points": 2.0}},
+	}
+	result := measureVelocity(cfg, iteration, items, finished, rolled)
+	assert.Equal(t, 3, result.Items, "an item moved back in is not counted twice")
+	assert.Equal(t, 2, result.RolledOver)
+	assert.Equal(t, 10.0, result.Committed)
+	assert.Equal(t, 3.0, result.Completed)
+	assert.Equal(t, "30%", completionRate(result))
+}
+
+func TestBuildVelocityReport(t *testing.T) {
+	tests := []struct {
+		name      string
+		completed []float64
+		average   float64
+		trend     float64
+	}{
+		{"rising", []float64{10, 12, 14}, 12, 2},
+		{"falling", []float64{20, 16, 15, 9}, 15, -3.4},
+		{"single", []float64{8}, 8, 0},
+		{"none", nil, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var iterations []velocityIteration
+			for _, completed := range tt.completed {
+				iterations = append(iterations, velocityIteration{Completed: completed})
+			}
+
+			report := buildVelocityReport(iterations)
+			require.NotNil(t, report.Iterations)
+			assert.Equal(t, tt.average, report.Average)
+			assert.Equal(t, tt.trend, report.Trend)
+		})
+	}
+}
+
+func TestRolledOverFrom(t *testing.T) {
+	iteration := project.Iteration{ID: "IT_3", Title: "Sprint 3"}
+	rolled := func(fromID string) issue.HistoryEvent {
+		return issue.HistoryEvent{Type: issue.HistoryRolledOver, IterationID: fromID}
+	}
+	items := []filter.ProjectIssue{{Number: 1, ID: "I_1"}, {Number: 2, ID: "I_2"}, {Number: 3, ID: "I_3"}, {Number: 4, ID: "I_4"}}
+	histories := map[string][]issue.HistoryEvent{
+		"I_1": {rolled("IT_3"), rolled("IT_4")},
+		"I_2": {rolled("IT_2")},
+		"I_3": {{Type: issue.HistoryStatusChanged}},
+	}
+
+	var numbers []int
+	for _, item := range rolledOverFrom(iteration, items, histories) {
+		numbers = append(numbers, item.Number)
+	}
+	assert.Equal(t, []int{1}, numbers, "matched by iteration ID; items without a history are skipped")
+}
@@ -23,6 +23,9 @@ var viewCmd = &cobra.Command{
 This command shows:
 - Basic issue information (number, title, state, labels)
 - Project status and custom fields (priority, status, etc.)
+- Parent issue, sub-issues with their state and project Status
+- Linked and closing pull requests with their review and merge state
+- With --history, a chronological log of project, status, label and assignment changes
 - Project board URL for quick access`,
 	Example: `  # View an issue by number
   gh pm view 123
@@ -33,6 +36,9 @@ This command shows:
   # View in JSON format
   gh pm view 789 --output json
 
+  # Show when the status changed and who changed it
+  gh pm view 123 --history
+
   # View with quiet output (URLs only)
   gh pm view 101 --quiet`,
 	Args: cobra.ExactArgs(1),
@@ -45,6 +51,7 @@ var (
 	viewQuiet    bool
 	viewWeb      bool
 	viewComments bool
+	viewHistory  bool
 )
 
 func init() {
@@ -57,6 +64,7 @@ func init() {
 	viewCmd.Flags().BoolVarP(&viewQuiet, "quiet", "q", false, "Only output URLs")
 	viewCmd.Flags().BoolVarP(&viewWeb, "web", "w", false, "Open in web browser")
 	viewCmd.Flags().BoolVar(&viewComments, "comments", false, "Include comments")
+	viewCmd.Flags().BoolVar(&viewHistory, "history", false, "Include the project, status, label and assignment history")
 }
 
 type ViewCommand struct {
@@ -150,6 +158,18 @@ func (c *ViewCommand) Execute(issueNumber int) error {
 		}
 	}
 
+	// Get parent, sub-issues and linked pull requests
+	if !viewQuiet {
+		relations, err := c.issueAPI.GetIssueRelations(issueDetails.ID, statusFieldName(c.config), c.config.GetProjectID())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not get related issues and pull requests: %v\n", err)
+		} else {
+			issueDetails.Parent = relations.Parent
+			issueDetails.SubIssues = relations.SubIssues
+			issueDetails.PullRequests = relations.PullRequests
+		}
+	}
+
 	// Get comments if requested
 	if viewComments {
 		comments, err := c.getIssueComments(issueNumber, repo)
@@ -160,6 +180,16 @@ func (c *ViewCommand) Execute(issueNumber int) error {
 		}
 	}
 
+	// Get field change history if requested
+	if viewHistory {
+		history, err := c.issueAPI.GetIssueHistory(issueDetails.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not get history: %v\n", err)
+		} else {
+			issueDetails.History = history
+		}
+	}
+
 	// Format and display output
 	return c.formatter.FormatIssueView(issueDetails)
 }
@@ -299,6 +329,11 @@ func (c *ViewCommand) openInBrowser(issueNumber int, repo string) error {
 	// Print the URL we're opening
 	fmt.Fprintf(os.Stderr, "Opening %s in your browser.\n", urlToOpen)
 
+	return openURL(urlToOpen)
+}
+
+// openURL opens a URL in the user's default web browser
+func openURL(urlToOpen string) error {
 	// Open URL in browser based on OS
 	var cmd *exec.Cmd
 	switch runtime.GOOS {
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+// workflowGuard checks status changes against the workflow rules in
+// .gh-pm.yml, refusing or warning about violations
+type workflowGuard struct {
+	config    *config.Config
+	force     bool
+	out       io.Writer
+	loadItems func() ([]filter.ProjectIssue, error)
+	counts    map[string]int // Status key -> items, read on the first WIP check
+}
+
+// newWorkflowGuard returns a guard, or nil when no workflow is configured
+func newWorkflowGuard(cfg *config.Config, force bool, out io.Writer, loadItems func() ([]filter.ProjectIssue, error)) *workflowGuard {
+	if cfg.Workflow == nil {
+		return nil
+	}
+	return &workflowGuard{config: cfg, force: force, out: out, loadItems: loadItems}
+}
+
+// violations lists the workflow rules that moving an item from one status to
+// another would break; statuses are keys or project option names
+func (g *workflowGuard) violations(from, to string) ([]string, error) {
+	workflow := g.config.Workflow
+	fromKey, toKey := g.config.StatusKey(from), g.config.StatusKey(to)
+	if toKey == "" || strings.EqualFold(from, to) || (fromKey != "" && fromKey == toKey) {
+		return nil, nil
+	}
+
+	var violations []string
+	if fromKey != "" && !workflow.AllowsTransition(fromKey, toKey) {
+		violations = append(violations, fmt.Sprintf("%s → %s is not an allowed transition (allowed: %s)",
+			fromKey, toKey, strings.Join(workflow.Transitions[fromKey], ", ")))
+	}
+
+	if limit, ok := workflow.WIPLimits[toKey]; ok {
+		if g.counts == nil {
+			if err := g.loadCounts(); err != nil {
+				return nil, err
+			}
+		}
+		if g.counts[toKey]+1 > limit {
+			violations = append(violations, fmt.Sprintf("%s already has %d of %d items (WIP limit)", toKey, g.counts[toKey], limit))
+		}
+	}
+	return violations, nil
+}
+
+// loadCounts counts the project items in each status
+func (g *workflowGuard) loadCounts() error {
+	items, err := g.loadItems()
+	if err != nil {
+		return fmt.Errorf("failed to count items for WIP limits: %w", err)
+	}
+	g.counts = map[string]int{}
+	statusField := statusFieldName(g.config)
+	for _, item := range items {
+		if status, ok := item.Fields[statusField].(string); ok {
+			if key := g.config.StatusKey(status); key != "" {
+				g.counts[key]++
+			}
+		}
+	}
+	return nil
+}
+
+// check returns an error when the move breaks the workflow and the rules are
+// enforced; otherwise it prints the violations as warnings
+func (g *workflowGuard) check(number int, from, to string) error {
+	if g == nil {
+		return nil
+	}
+	violations, err := g.violations(from, to)
+	if err != nil {
+		return err
+	}
+	return g.enforce(number, violations)
+}
+
+// checkRequirements refuses, or warns about, moving an item into a status
+// whose requirements it does not meet
+func (g *workflowGuard) checkRequirements(number int, to string, item filter.ProjectIssue) error {
+	if g == nil {
+		return nil
+	}
+	requirement, ok := g.config.Workflow.Requirements[g.config.StatusKey(to)]
+	if !ok {
+		return nil
+	}
+	var violations []string
+	for _, problem := range requirementProblems(g.config, requirement, item) {
+		violations = append(violations, fmt.Sprintf("%s requires %s", g.config.StatusKey(to), problem))
+	}
+	return g.enforce(number, violations)
+}
+
+// hasRequirements reports whether entering the status has requirements
+func (g *workflowGuard) hasRequirements(to string) bool {
+	if g == nil {
+		return false
+	}
+	_, ok := g.config.Workflow.Requirements[g.config.StatusKey(to)]
+	return ok
+}
+
+// enforce refuses the violations, or prints them when forced or warn-only
+func (g *workflowGuard) enforce(number int, violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	if !g.force && !g.config.Workflow.WarnOnly() {
+		return fmt.Errorf("moving #%d breaks the workflow: %s (use --force to override)", number, strings.Join(violations, "; "))
+	}
+	suffix := ""
+	if g.force {
+		suffix = " (forced)"
+	}
+	for _, violation := range violations {
+		fmt.Fprintf(g.out, "⚠ #%d: %s%s\n", number, violation, suffix)
+	}
+	return nil
+}
+
+// record counts a completed move towards the WIP of its new status
+func (g *workflowGuard) record(from, to string) {
+	if g == nil || g.counts == nil {
+		return
+	}
+	if key := g.config.StatusKey(from); key != "" && g.counts[key] > 0 {
+		g.counts[key]--
+	}
+	if key := g.config.StatusKey(to); key != "" {
+		g.counts[key]++
+	}
+}
+
+// requirementProblems lists what an item lacks to meet a status requirement
+func requirementProblems(cfg *config.Config, requirement config.StatusRequirement, item filter.ProjectIssue) []string {
+	var problems []string
+	for _, name := range requirement.Fields {
+		// Keys such as "estimate" map to the configured project field
+		fieldName := name
+		if field, ok := cfg.Fields[name]; ok && field.Field != "" {
+			fieldName = field.Field
+		}
+		if !hasFieldValue(item.Fields, fieldName) {
+			problems = append(problems, fieldName)
+		}
+	}
+	if requirement.Assignee && len(item.Assignees) == 0 {
+		problems = append(problems, "an assignee")
+	}
+	if requirement.Closed && !strings.EqualFold(item.State, "closed") {
+		problems = append(problems, "the issue to be closed")
+	}
+	return problems
+}
+
+// hasFieldValue reports whether the field, matched ignoring case, has a value
+func hasFieldValue(fields map[string]interface{}, name string) bool {
+	for key, value := range fields {
+		if !strings.EqualFold(key, name) || value == nil {
+			continue
+		}
+		if text, ok := value.(string); ok {
+			return strings.TrimSpace(text) != ""
+		}
+		return true
+	}
+	return false
+}
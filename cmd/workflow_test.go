@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+func workflowTestConfig(enforce string) *config.Config {
+	return &config.Config{
+		Fields: map[string]config.Field{
+			"status": {Field: "Status", Values: map[string]string{
+				"backlog": "Backlog", "ready": "Ready", "in_progress": "In Progress", "done": "Done",
+			}},
+		},
+		Workflow: &config.WorkflowConfig{
+			WIPLimits: map[string]int{"in_progress": 2},
+			Transitions: map[string][]string{
+				"backlog":     {"ready"},
+				"ready":       {"in_progress", "backlog"},
+				"in_progress": {"done", "ready"},
+			},
+			Enforce: enforce,
+		},
+	}
+}
+
+func workflowTestItems(statuses ...string) func() ([]filter.ProjectIssue, error) {
+	return func() ([]filter.ProjectIssue, error) {
+		var items []filter.ProjectIssue
+		for _, status := range statuses {
+			items = append(items, filter.ProjectIssue{Fields: map[string]interface{}{"Status": status}})
+		}
+		return items, nil
+	}
+}
+
+func TestWorkflowGuardCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		enforce  string
+		force    bool
+		from, to string
+		wip      []string
+		wantErr  string
+		wantOut  string
+	}{
+		{name: "allowed", from: "Ready", to: "in_progress", wip: []string{"In Progress"}},
+		{name: "same status", from: "Backlog", to: "backlog"},
+		{name: "no current status", from: "", to: "done"},
+		{name: "status outside the mapping", from: "Backlog", to: "Blocked"},
+		{
+			name: "transition not allowed", from: "Backlog", to: "done",
+			wantErr: "backlog → done is not an allowed transition (allowed: ready)",
+		},
+		{
+			name: "over WIP limit", from: "Ready", to: "in_progress", wip: []string{"In Progress", "in progress", "Ready"},
+			wantErr: "in_progress already has 2 of 2 items (WIP limit)",
+		},
+		{
+			name: "forced", force: true, from: "Backlog", to: "done",
+			wantOut: "⚠ #7: backlog → done is not an allowed transition (allowed: ready) (forced)\n",
+		},
+		{
+			name: "warn only", enforce: "warn", from: "Backlog", to: "done",
+			wantOut: "⚠ #7: backlog → done is not an allowed transition (allowed: ready)\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			guard := newWorkflowGuard(workflowTestConfig(tt.enforce), tt.force, &out, workflowTestItems(tt.wip...))
+
+			err := guard.check(7, tt.from, tt.to)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Contains(t, err.Error(), "--force")
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func TestWorkflowGuardRecord(t *testing.T) {
+	loads := 0
+	load := func() ([]filter.ProjectIssue, error) {
+		loads++
+		return workflowTestItems("In Progress")()
+	}
+	guard := newWorkflowGuard(workflowTestConfig(""), false, &bytes.Buffer{}, load)
+
+	require.NoError(t, guard.check(1, "Ready", "in_progress"))
+	guard.record("Ready", "in_progress")
+	err := guard.check(2, "Ready", "in_progress")
+	require.Error(t, err, "the first move counts towards the limit")
+
+	guard.record("in_progress", "done")
+	assert.NoError(t, guard.check(3, "Ready", "in_progress"))
+	assert.Equal(t, 1, loads, "items are read once")
+}
+
+func TestWorkflowGuardWithoutWorkflow(t *testing.T) {
+	guard := newWorkflowGuard(&config.Config{}, false, &bytes.Buffer{}, nil)
+	assert.Nil(t, guard)
+	assert.NoError(t, guard.check(1, "Backlog", "done"))
+	guard.record("Backlog", "done")
+}
+
+func requirementTestConfig() *config.Config {
+	cfg := workflowTestConfig("")
+	cfg.Fields["priority"] = config.Field{Field: "Priority", Values: map[string]string{"high": "High"}}
+	cfg.Fields["estimate"] = config.Field{Field: "Points"}
+	cfg.Workflow.Requirements = map[string]config.StatusRequirement{
+		"ready":       {Fields: []string{"priority", "estimate"}},
+		"in_progress": {Assignee: true},
+		"done":        {Closed: true},
+	}
+	return cfg
+}
+
+func TestRequirementProblems(t *testing.T) {
+	cfg := requirementTestConfig()
+	tests := []struct {
+		name        string
+		requirement config.StatusRequirement
+		item        filter.ProjectIssue
+		want        []string
+	}{
+		{
+			name:        "fields set",
+			requirement: config.StatusRequirement{Fields: []string{"priority", "estimate"}},
+			item:        filter.ProjectIssue{Fields: map[string]interface{}{"priority": "High", "Points": 3.0}},
+		},
+		{
+			name:        "fields missing or blank",
+			requirement: config.StatusRequirement{Fields: []string{"priority", "estimate", "Team"}},
+			item:        filter.ProjectIssue{Fields: map[string]interface{}{"Priority": " ", "Points": nil}},
+			want:        []string{"Priority", "Points", "Team"},
+		},
+		{
+			name:        "assignee",
+			requirement: config.StatusRequirement{Assignee: true},
+			item:        filter.ProjectIssue{},
+			want:        []string{"an assignee"},
+		},
+		{
+			name:        "closed",
+			requirement: config.StatusRequirement{Assignee: true, Closed: true},
+			item:        filter.ProjectIssue{State: "OPEN", Assignees: []string{"alice"}},
+			want:        []string{"the issue to be closed"},
+		},
+		{
+			name:        "closed met",
+			requirement: config.StatusRequirement{Closed: true},
+			item:        filter.ProjectIssue{State: "CLOSED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requirementProblems(cfg, tt.requirement, tt.item))
+		})
+	}
+}
+
+func TestWorkflowGuardCheckRequirements(t *testing.T) {
+	unready := filter.ProjectIssue{Fields: map[string]interface{}{"Priority": "High"}}
+
+	var out bytes.Buffer
+	guard := newWorkflowGuard(requirementTestConfig(), false, &out, nil)
+	err := guard.checkRequirements(7, "Ready", unready)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ready requires Points")
+	assert.True(t, guard.hasRequirements("ready"))
+	assert.False(t, guard.hasRequirements("backlog"))
+	assert.NoError(t, guard.checkRequirements(7, "backlog", unready))
+
+	guard = newWorkflowGuard(requirementTestConfig(), true, &out, nil)
+	require.NoError(t, guard.checkRequirements(7, "ready", unready))
+	assert.Equal(t, "⚠ #7: ready requires Points (forced)\n", out.String())
+}
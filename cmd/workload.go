@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+	"github.com/yahsan2/gh-pm/pkg/issue"
+	"github.com/yahsan2/gh-pm/pkg/output"
+	"github.com/yahsan2/gh-pm/pkg/project"
+)
+
+var (
+	workloadWIPLimit int
+	workloadLimit    int
+)
+
+// workloadCmd shows the open work of each assignee
+var workloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Show the open items and estimates of each assignee by status",
+	Long: `Show, for each assignee, the number of open project items and the sum of
+their estimates in each status. Finished items (closed, or in the "done"
+status) are left out.
+
+Settings come from the team section of .gh-pm.yml:
+  members        People outside this list are reported separately
+  wip_limit      Flag people with more items in progress than this
+  wip_statuses   Status keys that count as in progress (default: in_progress)
+  high_priority  Priority keys flagged when unassigned (default: critical, high)`,
+	Example: `  # Everyone's work in progress
+  gh pm workload
+
+  # Flag anyone with more than 2 items in progress
+  gh pm workload --wip-limit 2
+
+  # As JSON
+  gh pm workload --output json`,
+	Args: cobra.NoArgs,
+	RunE: runWorkload,
+}
+
+func init() {
+	rootCmd.AddCommand(workloadCmd)
+	workloadCmd.Flags().IntVar(&workloadWIPLimit, "wip-limit", 0, "Items in progress per person before they are flagged (default: team.wip_limit)")
+	workloadCmd.Flags().IntVarP(&workloadLimit, "limit", "L", 500, "Maximum number of project items to read")
+}
+
+// workloadCell is the open work of one assignee in one status
+type workloadCell struct {
+	Items    int     `json:"items"`
+	Estimate float64 `json:"estimate"`
+}
+
+// workloadAssignee is the open work of one assignee
+type workloadAssignee struct {
+	Assignee    string                  `json:"assignee"`
+	Statuses    map[string]workloadCell `json:"statuses"`
+	Total       workloadCell            `json:"total"`
+	WIP         int                     `json:"wip"`
+	OverLimit   bool                    `json:"over_limit"`
+	OutsideTeam bool                    `json:"outside_team"`
+}
+
+// workloadItem is an item called out by the report
+type workloadItem struct {
+	Number    int      `json:"number"`
+	Title     string   `json:"title"`
+	URL       string   `json:"url"`
+	Status    string   `json:"status,omitempty"`
+	Priority  string   `json:"priority,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+}
+
+// workloadReport is the open work of the team
+type workloadReport struct {
+	Statuses               []string           `json:"statuses"`
+	WIPLimit               int                `json:"wip_limit,omitempty"`
+	Assignees              []workloadAssignee `json:"assignees"`
+	UnassignedHighPriority []workloadItem     `json:"unassigned_high_priority"`
+	OutsideTeam            []workloadItem     `json:"outside_team"`
+}
+
+// workloadOptions are the resolved team settings
+type workloadOptions struct {
+	Members      []string
+	WIPLimit     int
+	WIPStatuses  []string // Option names
+	HighPriority []string // Option names
+	StatusOrder  []string
+}
+
+func runWorkload(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pm init' to create a configuration file", err)
+	}
+	if cfg.Project.Name == "" && cfg.Project.Number == 0 {
+		return fmt.Errorf("no project configured. Run 'gh pm init' to configure a project")
+	}
+
+	projectClient, err := project.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create project client: %w", err)
+	}
+	projectID, err := resolveProjectID(cfg, projectClient)
+	if err != nil {
+		return err
+	}
+
+	searchClient, err := issue.NewSearchClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create search client: %w", err)
+	}
+	items, err := searchClient.FetchProjectIssues(projectID, workloadLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project issues: %w", err)
+	}
+
+	options := workloadSettings(cfg)
+	if cmd.Flags().Changed("wip-limit") {
+		options.WIPLimit = workloadWIPLimit
+	}
+	if fields, err := loadProjectFields(cfg, projectClient, projectID); err == nil {
+		if field := findFieldByName(fields, statusFieldName(cfg)); field != nil {
+			for _, option := range field.Options {
+				options.StatusOrder = append(options.StatusOrder, option.Name)
+			}
+		}
+	}
+
+	report := buildWorkloadReport(cfg, items, options)
+	if outputFormat == "json" {
+		return output.NewFormatter(output.FormatJSON).Format(report)
+	}
+	return printWorkloadReport(report)
+}
+
+// workloadSettings resolves the team section of the configuration, mapping
+// status and priority keys to option names
+func workloadSettings(cfg *config.Config) workloadOptions {
+	team := config.TeamConfig{}
+	if cfg.Team != nil {
+		team = *cfg.Team
+	}
+
+	options := workloadOptions{Members: team.Members, WIPLimit: team.WIPLimit}
+	wipStatuses := team.WIPStatuses
+	if len(wipStatuses) == 0 {
+		wipStatuses = []string{"in_progress"}
+	}
+	for _, key := range wipStatuses {
+		fallback := key
+		if key == "in_progress" {
+			fallback = "In Progress"
+		}
+		options.WIPStatuses = append(options.WIPStatuses, statusOptionName(cfg, key, fallback))
+	}
+
+	highPriority := team.HighPriority
+	if len(highPriority) == 0 {
+		highPriority = []string{"critical", "high"}
+	}
+	for _, key := range highPriority {
+		name := key
+		if priority, ok := cfg.Fields["priority"]; ok && priority.Values[key] != "" {
+			name = priority.Values[key]
+		}
+		options.HighPriority = append(options.HighPriority, name)
+	}
+	return options
+}
+
+// buildWorkloadReport sums the open items of each assignee per status and
+// collects the unassigned high-priority items and the work outside the team
+func buildWorkloadReport(cfg *config.Config, items []filter.ProjectIssue, options workloadOptions) workloadReport {
+	report := workloadReport{
+		WIPLimit:               options.WIPLimit,
+		Assignees:              []workloadAssignee{},
+		UnassignedHighPriority: []workloadItem{},
+		OutsideTeam:            []workloadItem{},
+	}
+	statusField := statusFieldName(cfg)
+	priorityField := priorityFieldName(cfg)
+	byAssignee := map[string]*workloadAssignee{}
+	used := map[string]bool{}
+
+	for _, item := range items {
+		if isItemFinished(cfg, item) {
+			continue
+		}
+		status, _ := item.Fields[statusField].(string)
+		if status == "" {
+			status = "(no status)"
+		}
+		priority, _ := item.Fields[priorityField].(string)
+		entry := workloadItem{Number: item.Number, Title: item.Title, URL: item.URL, Status: status, Priority: priority, Assignees: item.Assignees}
+
+		assignees := item.Assignees
+		if len(assignees) == 0 {
+			assignees = []string{unassignedName}
+			if containsFold(options.HighPriority, priority) {
+				report.UnassignedHighPriority = append(report.UnassignedHighPriority, entry)
+			}
+		} else if len(options.Members) > 0 {
+			for _, assignee := range assignees {
+				if !containsFold(options.Members, assignee) {
+					report.OutsideTeam = append(report.OutsideTeam, entry)
+					break
+				}
+			}
+		}
+
+		// Estimates of shared items are split evenly, as in sprint planning
+		var estimate float64
+		if value := itemEstimate(cfg, item); value != nil {
+			estimate = *value / float64(len(assignees))
+		}
+		for _, name := range assignees {
+			load := byAssignee[strings.ToLower(name)]
+			if load == nil {
+				load = &workloadAssignee{Assignee: name, Statuses: map[string]workloadCell{}}
+				byAssignee[strings.ToLower(name)] = load
+			}
+			cell := load.Statuses[status]
+			cell.Items++
+			cell.Estimate += estimate
+			load.Statuses[status] = cell
+			load.Total.Items++
+			load.Total.Estimate += estimate
+			if containsFold(options.WIPStatuses, status) {
+				load.WIP++
+			}
+		}
+		used[status] = true
+	}
+
+	// Team members without open work are listed too
+	for _, member := range options.Members {
+		if byAssignee[strings.ToLower(member)] == nil {
+			byAssignee[strings.ToLower(member)] = &workloadAssignee{Assignee: member, Statuses: map[string]workloadCell{}}
+		}
+	}
+
+	for _, load := range byAssignee {
+		load.OverLimit = options.WIPLimit > 0 && load.Assignee != unassignedName && load.WIP > options.WIPLimit
+		load.OutsideTeam = len(options.Members) > 0 && load.Assignee != unassignedName && !containsFold(options.Members, load.Assignee)
+		report.Assignees = append(report.Assignees, *load)
+	}
+	sort.Slice(report.Assignees, func(i, j int) bool {
+		a, b := report.Assignees[i], report.Assignees[j]
+		if (a.Assignee == unassignedName) != (b.Assignee == unassignedName) {
+			return b.Assignee == unassignedName
+		}
+		return strings.ToLower(a.Assignee) < strings.ToLower(b.Assignee)
+	})
+
+	// Columns follow the project's status order, with other statuses after
+	for _, status := range options.StatusOrder {
+		if used[status] {
+			report.Statuses = append(report.Statuses, status)
+			delete(used, status)
+		}
+	}
+	var rest []string
+	for status := range used {
+		rest = append(rest, status)
+	}
+	sort.Strings(rest)
+	report.Statuses = append(report.Statuses, rest...)
+	if report.Statuses == nil {
+		report.Statuses = []string{}
+	}
+	return report
+}
+
+// containsFold reports whether the list contains the value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// workloadCellText formats a cell as "items (estimate)"
+func workloadCellText(cell workloadCell) string {
+	if cell.Items == 0 {
+		return "-"
+	}
+	if cell.Estimate == 0 {
+		return fmt.Sprintf("%d", cell.Items)
+	}
+	return fmt.Sprintf("%d (%s)", cell.Items, formatNumber(cell.Estimate))
+}
+
+func printWorkloadReport(report workloadReport) error {
+	if len(report.Assignees) == 0 {
+		fmt.Println("No open items")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"ASSIGNEE"}
+	for _, status := range report.Statuses {
+		header = append(header, strings.ToUpper(status))
+	}
+	header = append(header, "TOTAL", "WIP")
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, load := range report.Assignees {
+		name := load.Assignee
+		if load.OutsideTeam {
+			name += " *"
+		}
+		row := []string{name}
+		for _, status := range report.Statuses {
+			row = append(row, workloadCellText(load.Statuses[status]))
+		}
+		wip := fmt.Sprintf("%d", load.WIP)
+		if load.OverLimit {
+			wip = fmt.Sprintf("%d ⚠ over %d", load.WIP, report.WIPLimit)
+		}
+		row = append(row, workloadCellText(load.Total), wip)
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Println("\nCells show items (estimate).")
+
+	if len(report.UnassignedHighPriority) > 0 {
+		fmt.Printf("\nUnassigned high-priority items (%d):\n", len(report.UnassignedHighPriority))
+		for _, item := range report.UnassignedHighPriority {
+			fmt.Printf("  #%d %s  [%s, %s]\n", item.Number, truncate(item.Title, 60), item.Priority, item.Status)
+		}
+	}
+	if len(report.OutsideTeam) > 0 {
+		fmt.Printf("\n* Assigned to people outside the team (%d):\n", len(report.OutsideTeam))
+		for _, item := range report.OutsideTeam {
+			fmt.Printf("  #%d %s  [%s]\n", item.Number, truncate(item.Title, 60), strings.Join(item.Assignees, ", "))
+		}
+	}
+	return nil
+}
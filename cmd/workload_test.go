@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yahsan2/gh-pm/pkg/config"
+	"github.com/yahsan2/gh-pm/pkg/filter"
+)
+
+func TestWorkloadSettings(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{
+		"status":   {Field: "Status", Values: map[string]string{"in_progress": "Doing", "in_review": "Review"}},
+		"priority": {Field: "Priority", Values: map[string]string{"critical": "P0", "high": "P1"}},
+	}}
+
+	options := workloadSettings(cfg)
+	assert.Equal(t, []string{"Doing"}, options.WIPStatuses)
+	assert.Equal(t, []string{"P0", "P1"}, options.HighPriority)
+	assert.Zero(t, options.WIPLimit)
+
+	cfg.Team = &config.TeamConfig{Members: []string{"alice"}, WIPLimit: 2, WIPStatuses: []string{"in_progress", "in_review"}, HighPriority: []string{"critical", "Urgent"}}
+	options = workloadSettings(cfg)
+	assert.Equal(t, []string{"Doing", "Review"}, options.WIPStatuses)
+	assert.Equal(t, []string{"P0", "Urgent"}, options.HighPriority, "unmapped keys are used as option names")
+	assert.Equal(t, 2, options.WIPLimit)
+	assert.Equal(t, []string{"alice"}, options.Members)
+}
+
+func TestBuildWorkloadReport(t *testing.T) {
+	cfg := sprintTestConfig()
+	items := []filter.ProjectIssue{
+		{Number: 1, Assignees: []string{"alice"}, Fields: map[string]interface{}{"Status": "In Progress", "Points": 3.0}},
+		{Number: 2, Assignees: []string{"alice"}, Fields: map[string]interface{}{"Status": "In Progress", "Points": 2.0}},
+		{Number: 3, Assignees: []string{"alice", "bob"}, Fields: map[string]interface{}{"Status": "Review", "Points": 4.0}},
+		{Number: 4, Assignees: []string{"Alice"}, Fields: map[string]interface{}{"Status": "Todo"}},
+		{Number: 5, Fields: map[string]interface{}{"Status": "Todo", "Priority": "P0"}},
+		{Number: 6, Fields: map[string]interface{}{"Status": "Todo", "Priority": "P2"}},
+		{Number: 7, Assignees: []string{"carol"}, Fields: map[string]interface{}{"Status": "In Progress"}},
+		{Number: 8, Assignees: []string{"alice"}, State: "closed", Fields: map[string]interface{}{"Status": "In Progress"}},
+		{Number: 9, Assignees: []string{"bob"}, Fields: map[string]interface{}{"Status": "Shipped"}},
+	}
+	options := workloadOptions{
+		Members:      []string{"alice", "bob", "dave"},
+		WIPLimit:     2,
+		WIPStatuses:  []string{"In Progress", "Review"},
+		HighPriority: []string{"P0"},
+		StatusOrder:  []string{"Todo", "In Progress", "Review", "Shipped"},
+	}
+
+	report := buildWorkloadReport(cfg, items, options)
+	assert.Equal(t, []string{"Todo", "In Progress", "Review"}, report.Statuses, "finished items are left out")
+
+	var names []string
+	for _, load := range report.Assignees {
+		names = append(names, load.Assignee)
+	}
+	require.Equal(t, []string{"alice", "bob", "carol", "dave", unassignedName}, names, "logins are matched ignoring case")
+
+	alice := report.Assignees[0]
+	assert.Equal(t, workloadCell{Items: 2, Estimate: 5}, alice.Statuses["In Progress"])
+	assert.Equal(t, workloadCell{Items: 1, Estimate: 2}, alice.Statuses["Review"], "shared estimates are split")
+	assert.Equal(t, workloadCell{Items: 1}, alice.Statuses["Todo"])
+	assert.Equal(t, 3, alice.WIP)
+	assert.True(t, alice.OverLimit)
+	assert.False(t, alice.OutsideTeam)
+
+	bob := report.Assignees[1]
+	assert.Equal(t, 1, bob.WIP)
+	assert.False(t, bob.OverLimit)
+
+	carol := report.Assignees[2]
+	assert.True(t, carol.OutsideTeam)
+	assert.False(t, carol.OverLimit)
+
+	assert.Zero(t, report.Assignees[3].Total.Items, "idle team members are listed")
+	assert.False(t, report.Assignees[4].OutsideTeam, "unassigned is never outside the team")
+
+	require.Len(t, report.UnassignedHighPriority, 1)
+	assert.Equal(t, 5, report.UnassignedHighPriority[0].Number)
+	require.Len(t, report.OutsideTeam, 1)
+	assert.Equal(t, 7, report.OutsideTeam[0].Number)
+}
+
+func TestWorkloadCellText(t *testing.T) {
+	assert.Equal(t, "-", workloadCellText(workloadCell{}))
+	assert.Equal(t, "3", workloadCellText(workloadCell{Items: 3}))
+	assert.Equal(t, "2 (5.5)", workloadCellText(workloadCell{Items: 2, Estimate: 5.5}))
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/yahsan2/gh-pm/pkg/utils"
 )
 
 const ConfigFileName = ".gh-pm.yml"
@@ -18,6 +20,11 @@ type Config struct {
 	Defaults     DefaultsConfig          `yaml:"defaults"`
 	Fields       map[string]Field        `yaml:"fields"`
 	Triage       map[string]TriageConfig `yaml:"triage,omitempty"`
+	Rollup       *RollupConfig           `yaml:"rollup,omitempty"`
+	Sprint       *SprintConfig           `yaml:"sprint,omitempty"`
+	Stale        *StaleConfig            `yaml:"stale,omitempty"`
+	Team         *TeamConfig             `yaml:"team,omitempty"`
+	Workflow     *WorkflowConfig         `yaml:"workflow,omitempty"`
 	Metadata     *ConfigMetadata         `yaml:"metadata,omitempty"`
 }
 
@@ -48,13 +55,87 @@ type TriageConfig struct {
 	Instruction       string            `yaml:"instruction,omitempty"`
 	Apply             TriageApply       `yaml:"apply"`
 	Interactive       TriageInteractive `yaml:"interactive,omitempty"`
-	InteractiveFields map[string]bool   `yaml:"-"` // Runtime only, not persisted
+	MaxItems          int               `yaml:"max_items,omitempty"`    // Process at most this many issues per run
+	ConfirmOver       int               `yaml:"confirm_over,omitempty"` // Ask before triaging more issues than this
+	InteractiveFields map[string]bool   `yaml:"-"`                      // Runtime only, not persisted
+}
+
+// IsInteractive reports whether the triage configuration prompts for any field
+func (t TriageConfig) IsInteractive() bool {
+	return t.Interactive.Status || t.Interactive.Estimate || len(t.InteractiveFields) > 0
 }
 
 // TriageApply represents what to apply during triage
 type TriageApply struct {
 	Labels []string          `yaml:"labels,omitempty"`
 	Fields map[string]string `yaml:"fields,omitempty"`
+	Rollup bool              `yaml:"rollup,omitempty"` // Roll up sub-issue progress into the issue's fields
+}
+
+// RollupConfig describes how sub-issue progress is rolled up into the
+// parent's project fields. All fields are NUMBER project fields.
+type RollupConfig struct {
+	Source    string `yaml:"source,omitempty"`    // Field summed over sub-issues, e.g. Estimate
+	Progress  string `yaml:"progress,omitempty"`  // Set to the percent complete
+	Remaining string `yaml:"remaining,omitempty"` // Set to the sum of Source over open sub-issues
+	Total     string `yaml:"total,omitempty"`     // Set to the sum of Source over all sub-issues
+	Recursive bool   `yaml:"recursive,omitempty"` // Use all leaf descendants instead of direct sub-issues
+}
+
+// SprintConfig holds sprint planning settings
+type SprintConfig struct {
+	Capacity map[string]float64 `yaml:"capacity,omitempty"` // Estimate each assignee can take on per iteration
+}
+
+// StaleConfig holds the thresholds and nudges of the stale command
+type StaleConfig struct {
+	Thresholds map[string]string `yaml:"thresholds,omitempty"` // Status key -> age such as 7d
+	Label      string            `yaml:"label,omitempty"`      // Added by --action label
+	Comment    string            `yaml:"comment,omitempty"`    // Posted by --action comment
+	MoveTo     string            `yaml:"move_to,omitempty"`    // Status key for --action move
+}
+
+// TeamConfig describes the team for workload reports
+type TeamConfig struct {
+	Members      []string `yaml:"members,omitempty"`       // GitHub logins of the team
+	WIPLimit     int      `yaml:"wip_limit,omitempty"`     // Items in progress per person
+	WIPStatuses  []string `yaml:"wip_statuses,omitempty"`  // Status keys counted as in progress
+	HighPriority []string `yaml:"high_priority,omitempty"` // Priority keys flagged when unassigned
+}
+
+// WorkflowConfig restricts how items move between statuses
+type WorkflowConfig struct {
+	WIPLimits    map[string]int               `yaml:"wip_limits,omitempty"`   // Status key -> maximum items in it
+	Transitions  map[string][]string          `yaml:"transitions,omitempty"`  // Status key -> status keys it may move to
+	Requirements map[string]StatusRequirement `yaml:"requirements,omitempty"` // Status key -> what items in it need
+	Enforce      string                       `yaml:"enforce,omitempty"`      // refuse (default) or warn
+}
+
+// StatusRequirement is what an item needs before it may be in a status
+type StatusRequirement struct {
+	Fields   []string `yaml:"fields,omitempty"`   // Project fields, or keys of fields, that must be set
+	Assignee bool     `yaml:"assignee,omitempty"` // At least one assignee
+	Closed   bool     `yaml:"closed,omitempty"`   // The issue must be closed
+}
+
+// AllowsTransition reports whether an item may move between two status keys.
+// Statuses without an entry in transitions may move anywhere.
+func (w *WorkflowConfig) AllowsTransition(from, to string) bool {
+	allowed, ok := w.Transitions[from]
+	if !ok || from == to {
+		return true
+	}
+	for _, key := range allowed {
+		if key == to {
+			return true
+		}
+	}
+	return false
+}
+
+// WarnOnly reports whether violations are reported without refusing the change
+func (w *WorkflowConfig) WarnOnly() bool {
+	return w.Enforce == "warn"
 }
 
 // TriageInteractive represents interactive options for triage
@@ -247,6 +328,13 @@ func LoadConfig() (*Config, error) {
 	return &config, nil
 }
 
+// unmappedFields are field mappings that name a project field without
+// option values, such as number and iteration fields
+var unmappedFields = map[string]bool{
+	"iteration": true,
+	"estimate":  true,
+}
+
 // Validate checks if the configuration is valid
 func (c *Config) Validate() error {
 	// Check required fields
@@ -271,7 +359,7 @@ func (c *Config) Validate() error {
 			if field.Field == "" {
 				return fmt.Errorf("field name is required for '%s'", name)
 			}
-			if len(field.Values) == 0 {
+			if len(field.Values) == 0 && !unmappedFields[name] {
 				return fmt.Errorf("at least one value mapping is required for field '%s'", name)
 			}
 		}
@@ -294,9 +382,99 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.Rollup != nil {
+		if err := c.Rollup.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Workflow != nil {
+		if err := c.Workflow.validate(c.Fields["status"]); err != nil {
+			return err
+		}
+	}
+
+	if c.Stale != nil {
+		for status, age := range c.Stale.Thresholds {
+			if _, err := utils.ParseAge(age); err != nil {
+				return fmt.Errorf("stale threshold for '%s': %w", status, err)
+			}
+		}
+		if c.Stale.MoveTo != "" {
+			if status, ok := c.Fields["status"]; ok {
+				if _, exists := status.Values[c.Stale.MoveTo]; !exists {
+					return fmt.Errorf("stale move_to status '%s' is not defined in field mappings", c.Stale.MoveTo)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the rollup writes at least one field and has a source
+// for the estimate sums
+func (r *RollupConfig) Validate() error {
+	if r.Progress == "" && r.Remaining == "" && r.Total == "" {
+		return fmt.Errorf("rollup requires at least one of progress, remaining or total")
+	}
+	if r.Source == "" && (r.Remaining != "" || r.Total != "") {
+		return fmt.Errorf("rollup remaining and total require a source field")
+	}
 	return nil
 }
 
+// validate checks the enforce mode and that the workflow names known statuses
+func (w *WorkflowConfig) validate(status Field) error {
+	if w.Enforce != "" && w.Enforce != "refuse" && w.Enforce != "warn" {
+		return fmt.Errorf("invalid workflow enforce '%s': must be 'refuse' or 'warn'", w.Enforce)
+	}
+
+	known := func(key string) bool {
+		_, ok := status.Values[key]
+		return len(status.Values) == 0 || ok
+	}
+	for key, limit := range w.WIPLimits {
+		if limit < 0 {
+			return fmt.Errorf("workflow WIP limit for '%s' must not be negative", key)
+		}
+		if !known(key) {
+			return fmt.Errorf("workflow WIP limit status '%s' is not defined in field mappings", key)
+		}
+	}
+	for key := range w.Requirements {
+		if !known(key) {
+			return fmt.Errorf("workflow requirements status '%s' is not defined in field mappings", key)
+		}
+	}
+	for from, targets := range w.Transitions {
+		for _, key := range append([]string{from}, targets...) {
+			if !known(key) {
+				return fmt.Errorf("workflow transition status '%s' is not defined in field mappings", key)
+			}
+		}
+	}
+	return nil
+}
+
+// StatusKey maps a status key or project option name to its key in the
+// status field mapping, or "" when it is not mapped
+func (c *Config) StatusKey(value string) string {
+	status, ok := c.Fields["status"]
+	if !ok {
+		return ""
+	}
+	if _, ok := status.Values[value]; ok {
+		return value
+	}
+	for key, name := range status.Values {
+		if strings.EqualFold(name, value) {
+			return key
+		}
+	}
+	return ""
+}
+
 // GetProjectID returns the cached project ID if available
 func (c *Config) GetProjectID() string {
 	if c.Metadata != nil && c.Metadata.Project.ID != "" {
@@ -443,6 +443,20 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "at least one value mapping is required",
 		},
+		{
+			name: "iteration and estimate fields without values",
+			config: &Config{
+				Project: ProjectConfig{
+					Name: "My Project",
+				},
+				Repositories: []string{"owner/repo"},
+				Fields: map[string]Field{
+					"iteration": {Field: "Sprint"},
+					"estimate":  {Field: "Points"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid default priority",
 			config: &Config{
@@ -508,6 +522,119 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "rollup with progress only",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Rollup:       &RollupConfig{Progress: "Progress"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rollup without target fields",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Rollup:       &RollupConfig{Source: "Estimate"},
+			},
+			wantErr: true,
+			errMsg:  "rollup requires at least one of progress, remaining or total",
+		},
+		{
+			name: "rollup remaining without source",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Rollup:       &RollupConfig{Remaining: "Remaining"},
+			},
+			wantErr: true,
+			errMsg:  "require a source field",
+		},
+		{
+			name: "stale thresholds",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Stale:        &StaleConfig{Thresholds: map[string]string{"in_progress": "7d", "in_review": "2w"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "stale threshold without unit",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Stale:        &StaleConfig{Thresholds: map[string]string{"in_progress": "7"}},
+			},
+			wantErr: true,
+			errMsg:  "stale threshold for 'in_progress'",
+		},
+		{
+			name: "stale move_to not in status mapping",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Fields: map[string]Field{
+					"status": {Field: "Status", Values: map[string]string{"backlog": "Backlog"}},
+				},
+				Stale: &StaleConfig{MoveTo: "icebox"},
+			},
+			wantErr: true,
+			errMsg:  "stale move_to status 'icebox'",
+		},
+		{
+			name: "workflow",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Fields: map[string]Field{
+					"status": {Field: "Status", Values: map[string]string{"ready": "Ready", "in_progress": "In Progress"}},
+				},
+				Workflow: &WorkflowConfig{
+					WIPLimits:   map[string]int{"in_progress": 3},
+					Transitions: map[string][]string{"ready": {"in_progress"}},
+					Enforce:     "warn",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "workflow with unknown transition status",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Fields: map[string]Field{
+					"status": {Field: "Status", Values: map[string]string{"ready": "Ready"}},
+				},
+				Workflow: &WorkflowConfig{Transitions: map[string][]string{"ready": {"shipped"}}},
+			},
+			wantErr: true,
+			errMsg:  "workflow transition status 'shipped'",
+		},
+		{
+			name: "workflow with invalid enforce mode",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Workflow:     &WorkflowConfig{Enforce: "block"},
+			},
+			wantErr: true,
+			errMsg:  "invalid workflow enforce 'block'",
+		},
+		{
+			name: "workflow requirements for an unknown status",
+			config: &Config{
+				Project:      ProjectConfig{Name: "My Project"},
+				Repositories: []string{"owner/repo"},
+				Fields: map[string]Field{
+					"status": {Field: "Status", Values: map[string]string{"ready": "Ready"}},
+				},
+				Workflow: &WorkflowConfig{Requirements: map[string]StatusRequirement{"shipped": {Closed: true}}},
+			},
+			wantErr: true,
+			errMsg:  "workflow requirements status 'shipped'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -638,3 +765,44 @@ func TestLoadConfig(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+func TestWorkflowAllowsTransition(t *testing.T) {
+	workflow := &WorkflowConfig{Transitions: map[string][]string{
+		"backlog":     {"ready"},
+		"ready":       {"in_progress", "backlog"},
+		"in_progress": {"in_review"},
+	}}
+
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"backlog", "ready", true},
+		{"backlog", "in_progress", false},
+		{"ready", "backlog", true},
+		{"in_progress", "in_progress", true},
+		{"in_review", "done", true}, // No entry, so unrestricted
+	}
+
+	for _, tt := range tests {
+		if got := workflow.AllowsTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("AllowsTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestStatusKey(t *testing.T) {
+	cfg := &Config{Fields: map[string]Field{
+		"status": {Field: "Status", Values: map[string]string{"in_progress": "In Progress"}},
+	}}
+
+	if got := cfg.StatusKey("in_progress"); got != "in_progress" {
+		t.Errorf("StatusKey(key) = %q", got)
+	}
+	if got := cfg.StatusKey("in progress"); got != "in_progress" {
+		t.Errorf("StatusKey(option name) = %q", got)
+	}
+	if got := cfg.StatusKey("Blocked"); got != "" {
+		t.Errorf("StatusKey(unknown) = %q", got)
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StateFileName is the default name of the triage watch state file
+const StateFileName = ".gh-pm-state.json"
+
+// TriageState records which issues each triage configuration has already
+// processed, so watch mode only acts on newly matching issues
+type TriageState struct {
+	Triage map[string]map[int]time.Time `json:"triage"` // triage name -> issue number -> processed at
+	path   string
+}
+
+// DefaultStatePath returns the state file path next to the configuration file,
+// or in the current directory when no configuration file is found
+func DefaultStatePath() string {
+	if configPath := findConfigFile(); configPath != "" {
+		return filepath.Join(filepath.Dir(configPath), StateFileName)
+	}
+	return StateFileName
+}
+
+// LoadTriageState reads the state file at path. A missing file yields an empty state.
+func LoadTriageState(path string) (*TriageState, error) {
+	state := &TriageState{
+		Triage: make(map[string]map[int]time.Time),
+		path:   path,
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+	if state.Triage == nil {
+		state.Triage = make(map[string]map[int]time.Time)
+	}
+
+	return state, nil
+}
+
+// IsProcessed reports whether the triage configuration already handled the issue
+func (s *TriageState) IsProcessed(triageName string, issueNumber int) bool {
+	_, ok := s.Triage[triageName][issueNumber]
+	return ok
+}
+
+// MarkProcessed records that the triage configuration handled the issue
+func (s *TriageState) MarkProcessed(triageName string, issueNumber int, at time.Time) {
+	if s.Triage[triageName] == nil {
+		s.Triage[triageName] = make(map[int]time.Time)
+	}
+	s.Triage[triageName][issueNumber] = at
+}
+
+// Save writes the state atomically to the file it was loaded from
+func (s *TriageState) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+
+	return nil
+}
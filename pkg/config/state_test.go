@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTriageStateMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StateFileName)
+
+	state, err := LoadTriageState(path)
+	require.NoError(t, err)
+	assert.False(t, state.IsProcessed("tracked", 1))
+}
+
+func TestTriageStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StateFileName)
+	at := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	state, err := LoadTriageState(path)
+	require.NoError(t, err)
+
+	state.MarkProcessed("tracked", 42, at)
+	state.MarkProcessed("stale", 7, at)
+	require.NoError(t, state.Save())
+
+	loaded, err := LoadTriageState(path)
+	require.NoError(t, err)
+
+	assert.True(t, loaded.IsProcessed("tracked", 42))
+	assert.True(t, loaded.IsProcessed("stale", 7))
+	assert.False(t, loaded.IsProcessed("tracked", 7), "state is tracked per triage configuration")
+	assert.True(t, at.Equal(loaded.Triage["tracked"][42]))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err), "temporary file should be renamed into place")
+}
+
+func TestLoadTriageStateInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StateFileName)
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0644))
+
+	_, err := LoadTriageState(path)
+	assert.Error(t, err)
+}
+
+func TestTriageConfigIsInteractive(t *testing.T) {
+	tests := []struct {
+		name   string
+		config TriageConfig
+		want   bool
+	}{
+		{"apply only", TriageConfig{Apply: TriageApply{Labels: []string{"pm-tracked"}}}, false},
+		{"status", TriageConfig{Interactive: TriageInteractive{Status: true}}, true},
+		{"estimate", TriageConfig{Interactive: TriageInteractive{Estimate: true}}, true},
+		{"custom field", TriageConfig{InteractiveFields: map[string]bool{"priority": true}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.IsInteractive())
+		})
+	}
+}
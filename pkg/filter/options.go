@@ -28,28 +28,36 @@ func NewIssueFilters() *IssueFilters {
 
 // ProjectIssue represents an issue with project-specific fields
 type ProjectIssue struct {
-	Number     int                    `json:"number"`
-	Title      string                 `json:"title"`
-	State      string                 `json:"state"`
-	URL        string                 `json:"url"`
-	ID         string                 `json:"id"`
-	Body       string                 `json:"body,omitempty"`
-	Author     string                 `json:"author,omitempty"`
-	Assignees  []string               `json:"assignees,omitempty"`
-	Labels     []string               `json:"labels,omitempty"`
-	Milestone  string                 `json:"milestone,omitempty"`
-	CreatedAt  string                 `json:"createdAt,omitempty"`
-	UpdatedAt  string                 `json:"updatedAt,omitempty"`
-	ClosedAt   string                 `json:"closedAt,omitempty"`
-	Comments   int                    `json:"comments,omitempty"`
-	ProjectURL string                 `json:"projectUrl,omitempty"`
-	Fields     map[string]interface{} `json:"fields,omitempty"`
+	Number     int      `json:"number"`
+	Title      string   `json:"title"`
+	State      string   `json:"state"`
+	URL        string   `json:"url"`
+	ID         string   `json:"id"`
+	Body       string   `json:"body,omitempty"`
+	Author     string   `json:"author,omitempty"`
+	Assignees  []string `json:"assignees,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	Milestone  string   `json:"milestone,omitempty"`
+	CreatedAt  string   `json:"createdAt,omitempty"`
+	UpdatedAt  string   `json:"updatedAt,omitempty"`
+	ClosedAt   string   `json:"closedAt,omitempty"`
+	Comments   int      `json:"comments,omitempty"`
+	ProjectURL string   `json:"projectUrl,omitempty"`
+	ItemID     string   `json:"itemId,omitempty"` // Project item ID
+	// ItemUpdatedAt is when the project item last changed, including its field
+	// values, which do not touch the issue's UpdatedAt
+	ItemUpdatedAt string                 `json:"itemUpdatedAt,omitempty"`
+	Fields        map[string]interface{} `json:"fields,omitempty"`
+	// IterationIDs maps iteration field names to the item's iteration ID, as
+	// titles need not be unique
+	IterationIDs map[string]string `json:"-"`
 }
 
 // GitHubIssue represents a basic GitHub issue
 type GitHubIssue struct {
-	Number int    `json:"number"`
-	Title  string `json:"title"`
-	ID     string `json:"node_id"`
-	URL    string `json:"html_url"`
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	ID     string   `json:"node_id"`
+	URL    string   `json:"html_url"`
+	Labels []string `json:"labels,omitempty"`
 }
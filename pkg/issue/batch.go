@@ -1,12 +1,14 @@
 package issue
 
-// BatchResult represents the result of batch issue creation
+// BatchResult represents the result of batch issue creation or update
 type BatchResult struct {
-	Total     int          `json:"total"`
-	Succeeded int          `json:"succeeded"`
-	Failed    int          `json:"failed"`
-	Issues    []*Issue     `json:"issues"`
-	Errors    []BatchError `json:"errors,omitempty"`
+	Total     int             `json:"total"`
+	Succeeded int             `json:"succeeded"`
+	Failed    int             `json:"failed"`
+	Skipped   int             `json:"skipped,omitempty"`
+	Issues    []*Issue        `json:"issues"`
+	Errors    []BatchError    `json:"errors,omitempty"`
+	Conflicts []FieldConflict `json:"conflicts,omitempty"`
 }
 
 // BatchError represents an error during batch processing
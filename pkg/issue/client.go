@@ -150,6 +150,47 @@ func (c *Client) UpdateProjectItemField(projectID, itemID, fieldID, optionID str
 	return nil
 }
 
+// UpdateProjectItemFieldValue sets a field of any type on a project item. value is a
+// ProjectV2FieldValue input such as {"text": "..."}, {"number": 3} or {"iterationId": "..."}.
+func (c *Client) UpdateProjectItemFieldValue(projectID, itemID, fieldID string, value map[string]interface{}) error {
+	mutation := `
+		mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+			updateProjectV2ItemFieldValue(
+				input: {
+					projectId: $projectId
+					itemId: $itemId
+					fieldId: $fieldId
+					value: $value
+				}
+			) {
+				projectV2Item {
+					id
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"projectId": projectID,
+		"itemId":    itemID,
+		"fieldId":   fieldID,
+		"value":     value,
+	}
+
+	var result struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID string `json:"id"`
+			} `json:"projectV2Item"`
+		} `json:"updateProjectV2ItemFieldValue"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &result); err != nil {
+		return NewAPIError(fmt.Sprintf("failed to update field %s", fieldID), err)
+	}
+
+	return nil
+}
+
 // GetProjectItemID gets the project item ID for an issue if it exists in the project
 func (c *Client) GetProjectItemID(issueID, projectID string) (string, int, error) {
 	query := `
@@ -204,7 +245,7 @@ func (c *Client) GetProjectItemID(issueID, projectID string) (string, int, error
 
 // GetIssueDetails fetches issue details using gh issue view command
 func GetIssueDetails(number int, repo string) (*Issue, error) {
-	args := []string{"issue", "view", strconv.Itoa(number), "--json", "id,number,title,body,url,state,createdAt,updatedAt,labels"}
+	args := []string{"issue", "view", strconv.Itoa(number), "--json", "id,number,title,body,url,state,createdAt,updatedAt,labels,assignees"}
 
 	if repo != "" {
 		args = append(args, "--repo", repo)
@@ -232,12 +273,20 @@ func GetIssueDetails(number int, repo string) (*Issue, error) {
 			Name  string `json:"name"`
 			Color string `json:"color"`
 		} `json:"labels"`
+		Assignees []struct {
+			Login string `json:"login"`
+		} `json:"assignees"`
 	}
 
 	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
 		return nil, fmt.Errorf("failed to parse gh output: %w", err)
 	}
 
+	var assignees []string
+	for _, a := range result.Assignees {
+		assignees = append(assignees, a.Login)
+	}
+
 	// Convert labels
 	labels := make([]Label, len(result.Labels))
 	for i, l := range result.Labels {
@@ -256,6 +305,7 @@ func GetIssueDetails(number int, repo string) (*Issue, error) {
 		State:      result.State,
 		Repository: repo,
 		Labels:     labels,
+		Assignees:  assignees,
 		CreatedAt:  result.CreatedAt,
 		UpdatedAt:  result.UpdatedAt,
 	}, nil
@@ -433,3 +483,139 @@ func (c *Client) UpdateIssueWithRepo(number int, req IssueRequest, repo string)
 
 	return nil
 }
+
+// CloseIssueWithRepo closes an issue in a specific repo
+func (c *Client) CloseIssueWithRepo(number int, repo string) error {
+	return c.setIssueState("close", number, repo)
+}
+
+// ReopenIssueWithRepo reopens a closed issue in a specific repo
+func (c *Client) ReopenIssueWithRepo(number int, repo string) error {
+	return c.setIssueState("reopen", number, repo)
+}
+
+// AddLabelsWithRepo adds labels to an issue, keeping its existing labels
+func (c *Client) AddLabelsWithRepo(number int, repo string, labels []string) error {
+	args := []string{"issue", "edit", strconv.Itoa(number), "--add-label", strings.Join(labels, ",")}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	cmd := exec.Command("gh", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to add labels: %w\nstderr: %s", err, stderr.String())
+	}
+
+	return nil
+}
+
+// ListLabelsWithRepo returns the names of the labels defined in a repository
+func (c *Client) ListLabelsWithRepo(repo string) ([]string, error) {
+	args := []string{"label", "list", "--json", "name", "--limit", "1000"}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	cmd := exec.Command("gh", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w\nstderr: %s", err, stderr.String())
+	}
+
+	var result []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse gh output: %w", err)
+	}
+
+	names := make([]string, len(result))
+	for i, label := range result {
+		names[i] = label.Name
+	}
+	return names, nil
+}
+
+// ListCommentsWithRepo returns the comments of an issue, oldest first
+func (c *Client) ListCommentsWithRepo(number int, repo string) ([]Comment, error) {
+	args := []string{"issue", "view", strconv.Itoa(number), "--json", "comments"}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	cmd := exec.Command("gh", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w\nstderr: %s", err, stderr.String())
+	}
+
+	var result struct {
+		Comments []struct {
+			ID     string `json:"id"`
+			Author struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			Body      string    `json:"body"`
+			CreatedAt time.Time `json:"createdAt"`
+		} `json:"comments"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse gh output: %w", err)
+	}
+
+	comments := make([]Comment, len(result.Comments))
+	for i, comment := range result.Comments {
+		comments[i] = Comment{
+			ID:        comment.ID,
+			Author:    comment.Author.Login,
+			Body:      comment.Body,
+			CreatedAt: comment.CreatedAt,
+		}
+	}
+	return comments, nil
+}
+
+// AddCommentWithRepo posts a comment on an issue
+func (c *Client) AddCommentWithRepo(number int, repo, body string) error {
+	args := []string{"issue", "comment", strconv.Itoa(number), "--body", body}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	cmd := exec.Command("gh", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to add comment: %w\nstderr: %s", err, stderr.String())
+	}
+
+	return nil
+}
+
+// setIssueState runs "gh issue close" or "gh issue reopen"
+func (c *Client) setIssueState(action string, number int, repo string) error {
+	args := []string{"issue", action, strconv.Itoa(number)}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	cmd := exec.Command("gh", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to %s issue: %w\nstderr: %s", action, err, stderr.String())
+	}
+
+	return nil
+}
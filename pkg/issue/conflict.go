@@ -0,0 +1,73 @@
+package issue
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConflictPolicy decides what happens when a project field changed between
+// the time it was read and the time it is about to be updated
+type ConflictPolicy string
+
+const (
+	// ConflictSkip leaves items with conflicting fields untouched
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictPrompt asks the user whether to overwrite the newer value
+	ConflictPrompt ConflictPolicy = "prompt"
+	// ConflictForce overwrites the newer value without asking
+	ConflictForce ConflictPolicy = "force"
+)
+
+// ParseConflictPolicy parses a --on-conflict flag value
+func ParseConflictPolicy(value string) (ConflictPolicy, error) {
+	switch ConflictPolicy(strings.ToLower(strings.TrimSpace(value))) {
+	case "", ConflictSkip:
+		return ConflictSkip, nil
+	case ConflictPrompt:
+		return ConflictPrompt, nil
+	case ConflictForce:
+		return ConflictForce, nil
+	default:
+		return "", NewValidationError(fmt.Sprintf("invalid conflict policy '%s' (expected skip, prompt or force)", value), nil)
+	}
+}
+
+// FieldConflict describes a project field whose value changed since it was read
+type FieldConflict struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	Field      string `json:"field"`
+	Expected   string `json:"expected"`
+	Actual     string `json:"actual"`
+	Resolution string `json:"resolution"`
+}
+
+// Conflict resolutions recorded in FieldConflict.Resolution
+const (
+	ResolutionSkipped = "skipped"
+	ResolutionForced  = "forced"
+)
+
+// DetectConflicts compares the values read earlier with the current values for
+// the fields that are about to be updated. Missing values compare as empty.
+func DetectConflicts(fieldNames []string, snapshot, current map[string]string) []FieldConflict {
+	var conflicts []FieldConflict
+	seen := make(map[string]bool)
+
+	for _, name := range fieldNames {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if snapshot[name] != current[name] {
+			conflicts = append(conflicts, FieldConflict{
+				Field:    name,
+				Expected: snapshot[name],
+				Actual:   current[name],
+			})
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,87 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConflictPolicy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ConflictPolicy
+		wantErr  bool
+	}{
+		{input: "", expected: ConflictSkip},
+		{input: "skip", expected: ConflictSkip},
+		{input: "Prompt", expected: ConflictPrompt},
+		{input: " force ", expected: ConflictForce},
+		{input: "merge", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			policy, err := ParseConflictPolicy(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
+
+func TestDetectConflicts(t *testing.T) {
+	snapshot := map[string]string{"Status": "Backlog", "Priority": "P1"}
+
+	tests := []struct {
+		name     string
+		fields   []string
+		current  map[string]string
+		expected []FieldConflict
+	}{
+		{
+			name:     "unchanged",
+			fields:   []string{"Status", "Priority"},
+			current:  map[string]string{"Status": "Backlog", "Priority": "P1"},
+			expected: nil,
+		},
+		{
+			name:    "changed field",
+			fields:  []string{"Status"},
+			current: map[string]string{"Status": "Ready", "Priority": "P1"},
+			expected: []FieldConflict{
+				{Field: "Status", Expected: "Backlog", Actual: "Ready"},
+			},
+		},
+		{
+			name:     "changes to other fields are ignored",
+			fields:   []string{"Priority"},
+			current:  map[string]string{"Status": "Done", "Priority": "P1"},
+			expected: nil,
+		},
+		{
+			name:    "value cleared and duplicates reported once",
+			fields:  []string{"Priority", "Priority"},
+			current: map[string]string{"Status": "Backlog"},
+			expected: []FieldConflict{
+				{Field: "Priority", Expected: "P1", Actual: ""},
+			},
+		},
+		{
+			name:    "value set where none was",
+			fields:  []string{"Estimate"},
+			current: map[string]string{"Estimate": "3"},
+			expected: []FieldConflict{
+				{Field: "Estimate", Expected: "", Actual: "3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectConflicts(tt.fields, snapshot, tt.current))
+		})
+	}
+}
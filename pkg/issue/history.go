@@ -0,0 +1,279 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// History event types
+const (
+	HistoryAddedToProject     = "added_to_project"
+	HistoryRemovedFromProject = "removed_from_project"
+	HistoryStatusChanged      = "status_changed"
+	HistoryLabeled            = "labeled"
+	HistoryUnlabeled          = "unlabeled"
+	HistoryAssigned           = "assigned"
+	HistoryUnassigned         = "unassigned"
+	HistoryRolledOver         = "rolled_over"
+)
+
+// rolloverMarker starts the hidden note that sprint rollover leaves in the
+// comment it posts on each item it moves
+const rolloverMarker = "<!-- gh-pm rollover "
+
+// rolloverNote is the content of a rollover comment's hidden note
+type rolloverNote struct {
+	FromID string `json:"from_id"`
+	From   string `json:"from"`
+	ToID   string `json:"to_id"`
+	To     string `json:"to"`
+}
+
+// RolloverComment returns the comment sprint rollover posts on an item it moves
+// out of an iteration unfinished. The history reads it back as a rolled_over event.
+func RolloverComment(fromID, from, toID, to string) string {
+	note, _ := json.Marshal(rolloverNote{FromID: fromID, From: from, ToID: toID, To: to})
+	return fmt.Sprintf("Moved from %s to %s unfinished.\n\n%s%s -->", from, to, rolloverMarker, note)
+}
+
+// parseRolloverComment reads the hidden note of a rollover comment
+func parseRolloverComment(body string) (rolloverNote, bool) {
+	start := strings.Index(body, rolloverMarker)
+	if start < 0 {
+		return rolloverNote{}, false
+	}
+	rest := body[start+len(rolloverMarker):]
+	end := strings.Index(rest, " -->")
+	if end < 0 {
+		return rolloverNote{}, false
+	}
+	var note rolloverNote
+	if err := json.Unmarshal([]byte(rest[:end]), &note); err != nil || note.FromID == "" {
+		return rolloverNote{}, false
+	}
+	return note, true
+}
+
+// HistoryEvent is one project or field change in an issue's timeline
+type HistoryEvent struct {
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Project   string    `json:"project,omitempty"`
+	ProjectID string    `json:"project_id,omitempty"`
+	Field     string    `json:"field,omitempty"` // Status, Labels, Assignees or Iteration
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to,omitempty"`
+	// IterationID is the iteration a rolled_over item left
+	IterationID string `json:"iteration_id,omitempty"`
+}
+
+// historyNode is the GraphQL shape of the timeline events we ask for
+type historyNode struct {
+	Typename  string    `json:"__typename"`
+	CreatedAt time.Time `json:"createdAt"`
+	Actor     *struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Body    string `json:"body"`
+	Project *struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	} `json:"project"`
+	PreviousStatus string `json:"previousStatus"`
+	Status         string `json:"status"`
+	Label          *struct {
+		Name string `json:"name"`
+	} `json:"label"`
+	Assignee *struct {
+		Login string `json:"login"`
+	} `json:"assignee"`
+}
+
+// toEvent converts a timeline node, returning false for unknown types
+func (n historyNode) toEvent() (HistoryEvent, bool) {
+	event := HistoryEvent{CreatedAt: n.CreatedAt}
+	if n.Actor != nil {
+		event.Actor = n.Actor.Login
+	}
+	if n.Project != nil {
+		event.Project = n.Project.Title
+		event.ProjectID = n.Project.ID
+	}
+
+	switch n.Typename {
+	case "AddedToProjectV2Event":
+		event.Type = HistoryAddedToProject
+	case "RemovedFromProjectV2Event":
+		event.Type = HistoryRemovedFromProject
+	case "ProjectV2ItemStatusChangedEvent":
+		event.Type = HistoryStatusChanged
+		event.Field = "Status"
+		event.From = n.PreviousStatus
+		event.To = n.Status
+	case "LabeledEvent", "UnlabeledEvent":
+		event.Type = HistoryLabeled
+		event.Field = "Labels"
+		label := ""
+		if n.Label != nil {
+			label = n.Label.Name
+		}
+		if n.Typename == "UnlabeledEvent" {
+			event.Type = HistoryUnlabeled
+			event.From = label
+		} else {
+			event.To = label
+		}
+	case "AssignedEvent", "UnassignedEvent":
+		event.Type = HistoryAssigned
+		event.Field = "Assignees"
+		assignee := ""
+		if n.Assignee != nil {
+			assignee = n.Assignee.Login
+		}
+		if n.Typename == "UnassignedEvent" {
+			event.Type = HistoryUnassigned
+			event.From = assignee
+		} else {
+			event.To = assignee
+		}
+	case "IssueComment":
+		// Only the comments left by sprint rollover are history
+		note, ok := parseRolloverComment(n.Body)
+		if !ok {
+			return HistoryEvent{}, false
+		}
+		event.Type = HistoryRolledOver
+		event.Field = "Iteration"
+		event.From = note.From
+		event.To = note.To
+		event.IterationID = note.FromID
+		if n.Author != nil {
+			event.Actor = n.Author.Login
+		}
+	default:
+		return HistoryEvent{}, false
+	}
+	return event, true
+}
+
+// GetIssueHistory returns the project, status, label, assignment and sprint
+// rollover events of an issue, oldest first
+func (c *Client) GetIssueHistory(issueID string) ([]HistoryEvent, error) {
+	query := `
+		query($issueId: ID!, $cursor: String) {
+			node(id: $issueId) {
+				... on Issue {
+					timelineItems(first: 100, after: $cursor, itemTypes: [
+						ADDED_TO_PROJECT_V2_EVENT,
+						REMOVED_FROM_PROJECT_V2_EVENT,
+						PROJECT_V2_ITEM_STATUS_CHANGED_EVENT,
+						LABELED_EVENT,
+						UNLABELED_EVENT,
+						ASSIGNED_EVENT,
+						UNASSIGNED_EVENT,
+						ISSUE_COMMENT
+					]) {
+						nodes {
+							__typename
+							... on AddedToProjectV2Event {
+								createdAt
+								actor { login }
+								project { id title }
+							}
+							... on RemovedFromProjectV2Event {
+								createdAt
+								actor { login }
+								project { id title }
+							}
+							... on ProjectV2ItemStatusChangedEvent {
+								createdAt
+								actor { login }
+								project { id title }
+								previousStatus
+								status
+							}
+							... on LabeledEvent {
+								createdAt
+								actor { login }
+								label { name }
+							}
+							... on UnlabeledEvent {
+								createdAt
+								actor { login }
+								label { name }
+							}
+							... on AssignedEvent {
+								createdAt
+								actor { login }
+								assignee { ... on User { login } }
+							}
+							... on UnassignedEvent {
+								createdAt
+								actor { login }
+								assignee { ... on User { login } }
+							}
+							... on IssueComment {
+								createdAt
+								author { login }
+								body
+							}
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		}`
+
+	events := []HistoryEvent{}
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"issueId": issueID,
+			"cursor":  cursor,
+		}
+
+		var result struct {
+			Node struct {
+				TimelineItems struct {
+					Nodes    []historyNode `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"timelineItems"`
+			} `json:"node"`
+		}
+
+		if err := c.gql.Do(query, variables, &result); err != nil {
+			return nil, NewAPIError("failed to get issue history", err)
+		}
+
+		for _, node := range result.Node.TimelineItems.Nodes {
+			if event, ok := node.toEvent(); ok {
+				events = append(events, event)
+			}
+		}
+
+		if !result.Node.TimelineItems.PageInfo.HasNextPage {
+			break
+		}
+		endCursor := result.Node.TimelineItems.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	return events, nil
+}
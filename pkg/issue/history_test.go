@@ -0,0 +1,59 @@
+package issue
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueHistory(t *testing.T) {
+	body, err := json.Marshal(RolloverComment("IT_3", "Sprint 3", "IT_4", "Sprint 4"))
+	require.NoError(t, err)
+	rolloverBody := string(body)
+	client, stub := newStubClient(t,
+		`{"data":{"node":{"timelineItems":{"nodes":[
+			{"__typename":"AddedToProjectV2Event","createdAt":"2026-03-01T09:00:00Z","actor":{"login":"alice"},"project":{"id":"P_1","title":"Roadmap"}},
+			{"__typename":"ProjectV2ItemStatusChangedEvent","createdAt":"2026-03-03T10:00:00Z","actor":{"login":"bob"},"project":{"id":"P_1","title":"Roadmap"},"previousStatus":"Todo","status":"In Progress"},
+			{"__typename":"LabeledEvent","createdAt":"2026-03-02T08:00:00Z","actor":{"login":"alice"},"label":{"name":"bug"}}],
+			"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}}`,
+		`{"data":{"node":{"timelineItems":{"nodes":[
+			{"__typename":"UnassignedEvent","createdAt":"2026-03-04T08:00:00Z","actor":null,"assignee":{"login":"carol"}},
+			{"__typename":"IssueComment","createdAt":"2026-03-05T08:00:00Z","author":{"login":"carol"},"body":"Looks good"},
+			{"__typename":"IssueComment","createdAt":"2026-03-16T09:00:00Z","author":{"login":"alice"},"body":`+rolloverBody+`},
+			{"__typename":"MentionedEvent"}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+	)
+
+	events, err := client.GetIssueHistory("I_1")
+	require.NoError(t, err)
+
+	at := func(value string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return parsed
+	}
+	assert.Equal(t, []HistoryEvent{
+		{Type: HistoryAddedToProject, Actor: "alice", CreatedAt: at("2026-03-01T09:00:00Z"), Project: "Roadmap", ProjectID: "P_1"},
+		{Type: HistoryLabeled, Actor: "alice", CreatedAt: at("2026-03-02T08:00:00Z"), Field: "Labels", To: "bug"},
+		{Type: HistoryStatusChanged, Actor: "bob", CreatedAt: at("2026-03-03T10:00:00Z"), Project: "Roadmap", ProjectID: "P_1", Field: "Status", From: "Todo", To: "In Progress"},
+		{Type: HistoryUnassigned, CreatedAt: at("2026-03-04T08:00:00Z"), Field: "Assignees", From: "carol"},
+		{Type: HistoryRolledOver, Actor: "alice", CreatedAt: at("2026-03-16T09:00:00Z"), Field: "Iteration", From: "Sprint 3", To: "Sprint 4", IterationID: "IT_3"},
+	}, events, "events are sorted oldest first; unknown types and other comments are skipped")
+
+	require.Len(t, stub.requests, 2)
+	assert.Equal(t, "c1", stub.requests[1]["variables"].(map[string]interface{})["cursor"])
+}
+
+func TestParseRolloverComment(t *testing.T) {
+	note, ok := parseRolloverComment(RolloverComment("IT_3", `Sprint "3"`, "IT_4", "Sprint 4"))
+	require.True(t, ok)
+	assert.Equal(t, rolloverNote{FromID: "IT_3", From: `Sprint "3"`, ToID: "IT_4", To: "Sprint 4"}, note)
+
+	_, ok = parseRolloverComment("Moved from Sprint 3 to Sprint 4 unfinished.")
+	assert.False(t, ok)
+	_, ok = parseRolloverComment("<!-- gh-pm rollover not json -->")
+	assert.False(t, ok)
+}
@@ -23,21 +23,25 @@ type IssueData struct {
 
 // Issue represents a created GitHub issue with project metadata
 type Issue struct {
-	ID          string       `json:"id"`
-	Number      int          `json:"number"`
-	Title       string       `json:"title"`
-	Body        string       `json:"body,omitempty"`
-	URL         string       `json:"url"`
-	State       string       `json:"state"`
-	Repository  string       `json:"repository"`
-	Labels      []Label      `json:"labels"`
-	Assignees   []string     `json:"assignees,omitempty"`
-	Milestone   string       `json:"milestone,omitempty"`
-	ProjectItem *ProjectItem `json:"project_item,omitempty"`
-	ProjectURL  string       `json:"project_url,omitempty"`
-	Comments    []Comment    `json:"comments,omitempty"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	ID           string              `json:"id"`
+	Number       int                 `json:"number"`
+	Title        string              `json:"title"`
+	Body         string              `json:"body,omitempty"`
+	URL          string              `json:"url"`
+	State        string              `json:"state"`
+	Repository   string              `json:"repository"`
+	Labels       []Label             `json:"labels"`
+	Assignees    []string            `json:"assignees,omitempty"`
+	Milestone    string              `json:"milestone,omitempty"`
+	ProjectItem  *ProjectItem        `json:"project_item,omitempty"`
+	ProjectURL   string              `json:"project_url,omitempty"`
+	Comments     []Comment           `json:"comments,omitempty"`
+	Parent       *SubIssue           `json:"parent,omitempty"`
+	SubIssues    []SubIssue          `json:"sub_issues,omitempty"`
+	PullRequests []LinkedPullRequest `json:"pull_requests,omitempty"`
+	History      []HistoryEvent      `json:"history,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
 // IssueRequest represents the data for creating/updating an issue
@@ -0,0 +1,239 @@
+package issue
+
+// LinkedPullRequest is a pull request linked to an issue
+type LinkedPullRequest struct {
+	Number         int    `json:"number"`
+	Title          string `json:"title"`
+	URL            string `json:"url"`
+	Repository     string `json:"repository"`
+	State          string `json:"state"` // OPEN, CLOSED or MERGED
+	IsDraft        bool   `json:"is_draft"`
+	Merged         bool   `json:"merged"`
+	ReviewDecision string `json:"review_decision,omitempty"` // APPROVED, CHANGES_REQUESTED or REVIEW_REQUIRED
+	Closes         bool   `json:"closes"`                    // Merging it closes the issue
+}
+
+// IssueRelations are the parent, sub-issues and pull requests of an issue
+type IssueRelations struct {
+	Parent       *SubIssue
+	SubIssues    []SubIssue
+	PullRequests []LinkedPullRequest
+}
+
+// pullRequestFields is the GraphQL selection for linked pull requests
+const pullRequestFields = `
+	number
+	title
+	url
+	state
+	isDraft
+	merged
+	reviewDecision
+	repository { nameWithOwner }`
+
+// relationSubIssueFields is the GraphQL selection for the sub-issues of
+// GetIssueRelations; it needs the $statusField variable
+const relationSubIssueFields = `
+	id
+	number
+	title
+	state
+	url
+	projectItems(first: 20) {
+		nodes {
+			project { id }
+			fieldValueByName(name: $statusField) {
+				... on ProjectV2ItemFieldSingleSelectValue { name }
+			}
+		}
+	}`
+
+// linkedEventFields is the GraphQL selection for the timeline events that
+// link pull requests to an issue
+const linkedEventFields = `
+	nodes {
+		... on ConnectedEvent {
+			subject {
+				... on PullRequest {` + pullRequestFields + `
+				}
+			}
+		}
+		... on CrossReferencedEvent {
+			source {
+				... on PullRequest {` + pullRequestFields + `
+				}
+			}
+		}
+	}
+	pageInfo {
+		hasNextPage
+		endCursor
+	}`
+
+// linkedEvents is the GraphQL shape of a page of linking timeline events
+type linkedEvents struct {
+	Nodes []struct {
+		Subject *pullRequestNode `json:"subject"`
+		Source  *pullRequestNode `json:"source"`
+	} `json:"nodes"`
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+// pullRequestNode is the GraphQL shape of a pull request
+type pullRequestNode struct {
+	Number         int    `json:"number"`
+	Title          string `json:"title"`
+	URL            string `json:"url"`
+	State          string `json:"state"`
+	IsDraft        bool   `json:"isDraft"`
+	Merged         bool   `json:"merged"`
+	ReviewDecision string `json:"reviewDecision"`
+	Repository     struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+}
+
+func (n pullRequestNode) toLinked(closes bool) LinkedPullRequest {
+	return LinkedPullRequest{
+		Number:         n.Number,
+		Title:          n.Title,
+		URL:            n.URL,
+		Repository:     n.Repository.NameWithOwner,
+		State:          n.State,
+		IsDraft:        n.IsDraft,
+		Merged:         n.Merged,
+		ReviewDecision: n.ReviewDecision,
+		Closes:         closes,
+	}
+}
+
+// GetIssueRelations fetches the parent, the first 100 sub-issues with their
+// status in the given project (any project when empty) and the pull requests
+// that close or reference the issue
+func (c *Client) GetIssueRelations(issueID, statusField, projectID string) (*IssueRelations, error) {
+	query := `
+		query($issueId: ID!, $statusField: String!) {
+			node(id: $issueId) {
+				... on Issue {
+					parent {` + subIssueFields + `
+					}
+					subIssues(first: 100) {
+						nodes {` + relationSubIssueFields + `
+						}
+					}
+					closedByPullRequestsReferences(first: 20, includeClosedPrs: true) {
+						nodes {` + pullRequestFields + `
+						}
+					}
+					timelineItems(first: 100, itemTypes: [CONNECTED_EVENT, CROSS_REFERENCED_EVENT]) {` + linkedEventFields + `
+					}
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":     issueID,
+		"statusField": statusField,
+	}
+
+	var result struct {
+		Node struct {
+			Parent    *SubIssue `json:"parent"`
+			SubIssues struct {
+				Nodes []treeNode `json:"nodes"`
+			} `json:"subIssues"`
+			ClosedByPullRequestsReferences struct {
+				Nodes []pullRequestNode `json:"nodes"`
+			} `json:"closedByPullRequestsReferences"`
+			TimelineItems linkedEvents `json:"timelineItems"`
+		} `json:"node"`
+	}
+
+	if err := c.gql.Do(query, variables, &result); err != nil {
+		return nil, NewAPIError("failed to get issue relations", err)
+	}
+	events, err := c.remainingLinkedEvents(issueID, result.Node.TimelineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := &IssueRelations{
+		Parent:       result.Node.Parent,
+		SubIssues:    []SubIssue{},
+		PullRequests: []LinkedPullRequest{},
+	}
+
+	for _, node := range result.Node.SubIssues.Nodes {
+		tree := node.toTree(projectID)
+		relations.SubIssues = append(relations.SubIssues, SubIssue{
+			ID:     tree.ID,
+			Number: tree.Number,
+			Title:  tree.Title,
+			State:  tree.State,
+			URL:    tree.URL,
+			Status: tree.Status,
+		})
+	}
+
+	// Closing pull requests first, then the ones that only mention the issue
+	seen := make(map[string]bool)
+	for _, pr := range result.Node.ClosedByPullRequestsReferences.Nodes {
+		if pr.URL == "" || seen[pr.URL] {
+			continue
+		}
+		seen[pr.URL] = true
+		relations.PullRequests = append(relations.PullRequests, pr.toLinked(true))
+	}
+	for _, item := range events.Nodes {
+		pr := item.Subject
+		if pr == nil {
+			pr = item.Source
+		}
+		// Events about issues decode as empty pull requests
+		if pr == nil || pr.URL == "" || seen[pr.URL] {
+			continue
+		}
+		seen[pr.URL] = true
+		relations.PullRequests = append(relations.PullRequests, pr.toLinked(false))
+	}
+
+	return relations, nil
+}
+
+// remainingLinkedEvents reads the pages of linking timeline events after the
+// first, returning all of them
+func (c *Client) remainingLinkedEvents(issueID string, first linkedEvents) (linkedEvents, error) {
+	query := `
+		query($issueId: ID!, $cursor: String) {
+			node(id: $issueId) {
+				... on Issue {
+					timelineItems(first: 100, after: $cursor, itemTypes: [CONNECTED_EVENT, CROSS_REFERENCED_EVENT]) {` + linkedEventFields + `
+					}
+				}
+			}
+		}`
+
+	events := first
+	for events.PageInfo.HasNextPage {
+		variables := map[string]interface{}{
+			"issueId": issueID,
+			"cursor":  events.PageInfo.EndCursor,
+		}
+
+		var result struct {
+			Node struct {
+				TimelineItems linkedEvents `json:"timelineItems"`
+			} `json:"node"`
+		}
+		if err := c.gql.Do(query, variables, &result); err != nil {
+			return linkedEvents{}, NewAPIError("failed to get linked pull requests", err)
+		}
+
+		events.Nodes = append(events.Nodes, result.Node.TimelineItems.Nodes...)
+		events.PageInfo = result.Node.TimelineItems.PageInfo
+	}
+	return events, nil
+}
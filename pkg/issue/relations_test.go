@@ -0,0 +1,70 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueRelations(t *testing.T) {
+	client, stub := newStubClient(t,
+		`{"data":{"node":{
+			"parent":{"id":"I_1","number":1,"title":"Epic","state":"OPEN","url":"u1"},
+			"subIssues":{"nodes":[
+				{"id":"I_3","number":3,"title":"API","state":"CLOSED","url":"u3","assignees":{"nodes":[]},
+					"projectItems":{"nodes":[
+						{"project":{"id":"P_other"},"fieldValueByName":{"name":"Backlog"}},
+						{"project":{"id":"P_1"},"fieldValueByName":{"name":"Done"}}]},
+					"subIssuesSummary":{"total":0}},
+				{"id":"I_4","number":4,"title":"Docs","state":"OPEN","url":"u4","assignees":{"nodes":[]},"projectItems":{"nodes":[]},"subIssuesSummary":{"total":0}}]},
+			"closedByPullRequestsReferences":{"nodes":[
+				{"number":10,"title":"Add API","url":"pr10","state":"OPEN","isDraft":false,"merged":false,"reviewDecision":"APPROVED","repository":{"nameWithOwner":"o/r"}}]},
+			"timelineItems":{"nodes":[
+				{"source":{"number":10,"title":"Add API","url":"pr10","state":"OPEN","isDraft":false,"merged":false,"reviewDecision":"APPROVED","repository":{"nameWithOwner":"o/r"}}},
+				{"source":{}},
+				{"subject":{"number":11,"title":"Docs draft","url":"pr11","state":"MERGED","isDraft":true,"merged":true,"reviewDecision":null,"repository":{"nameWithOwner":"o/docs"}}}],
+				"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}
+		}}}`,
+		`{"data":{"node":{"timelineItems":{"nodes":[
+			{"source":{"number":12,"title":"Follow-up","url":"pr12","state":"OPEN","isDraft":false,"merged":false,"reviewDecision":null,"repository":{"nameWithOwner":"o/r"}}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+	)
+
+	relations, err := client.GetIssueRelations("I_2", "Status", "P_1")
+	require.NoError(t, err)
+
+	require.NotNil(t, relations.Parent)
+	assert.Equal(t, 1, relations.Parent.Number)
+
+	assert.Equal(t, []SubIssue{
+		{ID: "I_3", Number: 3, Title: "API", State: "CLOSED", URL: "u3", Status: "Done"},
+		{ID: "I_4", Number: 4, Title: "Docs", State: "OPEN", URL: "u4"},
+	}, relations.SubIssues)
+
+	assert.Equal(t, []LinkedPullRequest{
+		{Number: 10, Title: "Add API", URL: "pr10", Repository: "o/r", State: "OPEN", ReviewDecision: "APPROVED", Closes: true},
+		{Number: 11, Title: "Docs draft", URL: "pr11", Repository: "o/docs", State: "MERGED", IsDraft: true, Merged: true},
+		{Number: 12, Title: "Follow-up", URL: "pr12", Repository: "o/r", State: "OPEN"},
+	}, relations.PullRequests, "closing pull requests come first and are not repeated")
+
+	vars := stub.requests[0]["variables"].(map[string]interface{})
+	assert.Equal(t, "I_2", vars["issueId"])
+	assert.Equal(t, "Status", vars["statusField"])
+	assert.NotContains(t, vars, "numberField")
+
+	require.Len(t, stub.requests, 2, "linking events are read page by page")
+	assert.Equal(t, "c1", stub.requests[1]["variables"].(map[string]interface{})["cursor"])
+}
+
+func TestGetIssueRelationsWithoutParent(t *testing.T) {
+	client, _ := newStubClient(t,
+		`{"data":{"node":{"parent":null,"subIssues":{"nodes":[]},"closedByPullRequestsReferences":{"nodes":[]},"timelineItems":{"nodes":[]}}}}`,
+	)
+
+	relations, err := client.GetIssueRelations("I_2", "Status", "")
+	require.NoError(t, err)
+	assert.Nil(t, relations.Parent)
+	assert.Empty(t, relations.SubIssues)
+	assert.Empty(t, relations.PullRequests)
+}
@@ -99,7 +99,7 @@ func (s *SearchClient) SearchIssues(filters *filter.IssueFilters) ([]filter.GitH
 	}
 
 	// Add JSON output
-	args = append(args, "--json", "number,title,url,id")
+	args = append(args, "--json", "number,title,url,id,labels")
 
 	cmd := exec.Command("gh", args...)
 	output, err := cmd.CombinedOutput()
@@ -112,6 +112,9 @@ func (s *SearchClient) SearchIssues(filters *filter.IssueFilters) ([]filter.GitH
 		Title  string `json:"title"`
 		URL    string `json:"url"`
 		ID     string `json:"id"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
 	}
 
 	if err := json.Unmarshal(output, &issues); err != nil {
@@ -121,11 +124,16 @@ func (s *SearchClient) SearchIssues(filters *filter.IssueFilters) ([]filter.GitH
 	// Convert to GitHubIssue format
 	var result []filter.GitHubIssue
 	for _, issue := range issues {
+		var labels []string
+		for _, label := range issue.Labels {
+			labels = append(labels, label.Name)
+		}
 		result = append(result, filter.GitHubIssue{
 			Number: issue.Number,
 			Title:  issue.Title,
 			ID:     issue.ID,
 			URL:    issue.URL,
+			Labels: labels,
 		})
 	}
 
@@ -216,7 +224,8 @@ func (s *SearchClient) GetProjectIssues(projectID string) ([]filter.GitHubIssue,
 	return allIssues, nil
 }
 
-// FetchProjectIssues fetches project issues with field values and applies filtering
+// FetchProjectIssues fetches project issues with field values and applies filtering.
+// A limit of 0 or less reads every item of the project.
 func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter.ProjectIssue, error) {
 	query := `
 		query($projectId: ID!, $endCursor: String, $limit: Int!) {
@@ -230,6 +239,7 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 						nodes {
 							id
 							databaseId
+							updatedAt
 							fieldValues(first: 20) {
 								nodes {
 									... on ProjectV2ItemFieldTextValue {
@@ -271,6 +281,7 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 											}
 										}
 										title
+										iterationId
 									}
 								}
 							}
@@ -315,10 +326,16 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 	var allIssues []filter.ProjectIssue
 	var endCursor *string
 
+	// GitHub returns at most 100 items per page
+	pageSize := 100
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+
 	for {
 		variables := map[string]interface{}{
 			"projectId": projectID,
-			"limit":     limit,
+			"limit":     pageSize,
 		}
 		if endCursor != nil {
 			variables["endCursor"] = *endCursor
@@ -334,6 +351,7 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 					Nodes []struct {
 						ID          string `json:"id"`
 						DatabaseID  int    `json:"databaseId"`
+						UpdatedAt   string `json:"updatedAt"`
 						FieldValues struct {
 							Nodes []interface{} `json:"nodes"`
 						} `json:"fieldValues"`
@@ -384,19 +402,21 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 			}
 
 			issue := filter.ProjectIssue{
-				Number:    item.Content.Number,
-				Title:     item.Content.Title,
-				State:     strings.ToLower(item.Content.State),
-				URL:       item.Content.URL,
-				ID:        item.Content.ID,
-				Body:      item.Content.Body,
-				Author:    item.Content.Author.Login,
-				Milestone: item.Content.Milestone.Title,
-				CreatedAt: item.Content.CreatedAt,
-				UpdatedAt: item.Content.UpdatedAt,
-				ClosedAt:  item.Content.ClosedAt,
-				Comments:  item.Content.Comments.TotalCount,
-				Fields:    make(map[string]interface{}),
+				Number:        item.Content.Number,
+				Title:         item.Content.Title,
+				State:         strings.ToLower(item.Content.State),
+				URL:           item.Content.URL,
+				ID:            item.Content.ID,
+				Body:          item.Content.Body,
+				Author:        item.Content.Author.Login,
+				Milestone:     item.Content.Milestone.Title,
+				CreatedAt:     item.Content.CreatedAt,
+				UpdatedAt:     item.Content.UpdatedAt,
+				ClosedAt:      item.Content.ClosedAt,
+				Comments:      item.Content.Comments.TotalCount,
+				ItemID:        item.ID,
+				ItemUpdatedAt: item.UpdatedAt,
+				Fields:        make(map[string]interface{}),
 			}
 
 			// Add assignees
@@ -425,6 +445,12 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 								issue.Fields[fieldName] = name
 							} else if title, ok := fv["title"].(string); ok {
 								issue.Fields[fieldName] = title
+								if iterationID, ok := fv["iterationId"].(string); ok {
+									if issue.IterationIDs == nil {
+										issue.IterationIDs = make(map[string]string)
+									}
+									issue.IterationIDs[fieldName] = iterationID
+								}
 							}
 						}
 					}
@@ -441,14 +467,14 @@ func (s *SearchClient) FetchProjectIssues(projectID string, limit int) ([]filter
 		}
 
 		// Check if we've fetched enough or there are no more pages
-		if len(allIssues) >= limit || !result.Node.Items.PageInfo.HasNextPage {
+		if (limit > 0 && len(allIssues) >= limit) || !result.Node.Items.PageInfo.HasNextPage {
 			break
 		}
 		endCursor = &result.Node.Items.PageInfo.EndCursor
 	}
 
 	// Trim to limit
-	if len(allIssues) > limit {
+	if limit > 0 && len(allIssues) > limit {
 		allIssues = allIssues[:limit]
 	}
 
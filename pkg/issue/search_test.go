@@ -1,6 +1,7 @@
 package issue
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -319,3 +320,19 @@ func TestSearchClientEdgeCases(t *testing.T) {
 		assert.Len(t, filtered, 0)
 	})
 }
+
+func TestFetchProjectIssuesWithoutLimit(t *testing.T) {
+	page := func(number int, hasNext bool) string {
+		return fmt.Sprintf(`{"data":{"node":{"items":{"pageInfo":{"hasNextPage":%t,"endCursor":"c%d"},"nodes":[
+			{"id":"PVTI_%d","content":{"id":"I_%d","number":%d,"title":"Issue","state":"OPEN"}}]}}}}`, hasNext, number, number, number, number)
+	}
+	client, stub := newStubClient(t, page(1, true), page(2, true), page(3, false))
+	search := &SearchClient{client: client, config: &config.Config{}}
+
+	items, err := search.FetchProjectIssues("P_1", 0)
+	require.NoError(t, err)
+	require.Len(t, items, 3, "a limit of 0 reads every page")
+	assert.Equal(t, 3, items[2].Number)
+	require.Len(t, stub.requests, 3)
+	assert.Equal(t, float64(100), stub.requests[0]["variables"].(map[string]interface{})["limit"])
+}
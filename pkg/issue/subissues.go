@@ -0,0 +1,209 @@
+package issue
+
+import (
+	"fmt"
+)
+
+// SubIssue represents an issue linked to a parent through GitHub's native issue hierarchy
+type SubIssue struct {
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	URL    string `json:"url"`
+	Status string `json:"status,omitempty"` // Project Status, when fetched
+}
+
+// subIssueFields is the GraphQL selection used for sub-issue nodes
+const subIssueFields = `
+	id
+	number
+	title
+	state
+	url`
+
+// GetSubIssues returns the sub-issues of an issue in their hierarchy order
+func (c *Client) GetSubIssues(issueID string) ([]SubIssue, error) {
+	query := `
+		query($issueId: ID!, $cursor: String) {
+			node(id: $issueId) {
+				... on Issue {
+					subIssues(first: 100, after: $cursor) {
+						nodes {` + subIssueFields + `
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		}`
+
+	var subIssues []SubIssue
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"issueId": issueID,
+			"cursor":  cursor,
+		}
+
+		var result struct {
+			Node struct {
+				SubIssues struct {
+					Nodes    []SubIssue `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"subIssues"`
+			} `json:"node"`
+		}
+
+		if err := c.gql.Do(query, variables, &result); err != nil {
+			return nil, NewAPIError("failed to get sub-issues", err)
+		}
+
+		subIssues = append(subIssues, result.Node.SubIssues.Nodes...)
+
+		if !result.Node.SubIssues.PageInfo.HasNextPage {
+			break
+		}
+		endCursor := result.Node.SubIssues.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+
+	return subIssues, nil
+}
+
+// AddSubIssueByURL links the issue at subIssueURL as a sub-issue of the parent
+// and returns the linked sub-issue
+func (c *Client) AddSubIssueByURL(parentID, subIssueURL string) (SubIssue, error) {
+	mutation := `
+		mutation($issueId: ID!, $subIssueUrl: String!) {
+			addSubIssue(input: {issueId: $issueId, subIssueUrl: $subIssueUrl}) {
+				subIssue {` + subIssueFields + `
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":     parentID,
+		"subIssueUrl": subIssueURL,
+	}
+
+	var result struct {
+		AddSubIssue struct {
+			SubIssue SubIssue `json:"subIssue"`
+		} `json:"addSubIssue"`
+	}
+
+	if err := c.gql.Do(mutation, variables, &result); err != nil {
+		return SubIssue{}, NewAPIError(fmt.Sprintf("failed to link %s as a sub-issue", subIssueURL), err)
+	}
+
+	return result.AddSubIssue.SubIssue, nil
+}
+
+// AddSubIssue links an existing issue as a sub-issue of the parent
+func (c *Client) AddSubIssue(parentID, subIssueID string) error {
+	mutation := `
+		mutation($issueId: ID!, $subIssueId: ID!) {
+			addSubIssue(input: {issueId: $issueId, subIssueId: $subIssueId}) {
+				subIssue { id }
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":    parentID,
+		"subIssueId": subIssueID,
+	}
+
+	var result struct{}
+	if err := c.gql.Do(mutation, variables, &result); err != nil {
+		return NewAPIError("failed to add sub-issue", err)
+	}
+	return nil
+}
+
+// RemoveSubIssue unlinks a sub-issue from its parent
+func (c *Client) RemoveSubIssue(parentID, subIssueID string) error {
+	mutation := `
+		mutation($issueId: ID!, $subIssueId: ID!) {
+			removeSubIssue(input: {issueId: $issueId, subIssueId: $subIssueId}) {
+				subIssue { id }
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":    parentID,
+		"subIssueId": subIssueID,
+	}
+
+	var result struct{}
+	if err := c.gql.Do(mutation, variables, &result); err != nil {
+		return NewAPIError("failed to remove sub-issue", err)
+	}
+	return nil
+}
+
+// ReprioritizeSubIssue moves a sub-issue directly before beforeID or after
+// afterID within its parent's sub-issue list; exactly one of them is set
+func (c *Client) ReprioritizeSubIssue(parentID, subIssueID, beforeID, afterID string) error {
+	if (beforeID == "") == (afterID == "") {
+		return fmt.Errorf("exactly one of before and after is required")
+	}
+
+	mutation := `
+		mutation($issueId: ID!, $subIssueId: ID!, $beforeId: ID, $afterId: ID) {
+			reprioritizeSubIssue(input: {issueId: $issueId, subIssueId: $subIssueId, beforeId: $beforeId, afterId: $afterId}) {
+				issue { id }
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":    parentID,
+		"subIssueId": subIssueID,
+		"beforeId":   nil,
+		"afterId":    nil,
+	}
+	if beforeID != "" {
+		variables["beforeId"] = beforeID
+	} else {
+		variables["afterId"] = afterID
+	}
+
+	var result struct{}
+	if err := c.gql.Do(mutation, variables, &result); err != nil {
+		return NewAPIError("failed to reorder sub-issue", err)
+	}
+	return nil
+}
+
+// GetParentIssue returns the parent of an issue, or nil when it has none
+func (c *Client) GetParentIssue(issueID string) (*SubIssue, error) {
+	query := `
+		query($issueId: ID!) {
+			node(id: $issueId) {
+				... on Issue {
+					parent {` + subIssueFields + `
+					}
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId": issueID,
+	}
+
+	var result struct {
+		Node struct {
+			Parent *SubIssue `json:"parent"`
+		} `json:"node"`
+	}
+	if err := c.gql.Do(query, variables, &result); err != nil {
+		return nil, NewAPIError("failed to get parent issue", err)
+	}
+	return result.Node.Parent, nil
+}
@@ -0,0 +1,135 @@
+package issue
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLStub answers GraphQL requests with canned responses, in order
+type graphQLStub struct {
+	responses []string
+	requests  []map[string]interface{}
+}
+
+func (s *graphQLStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body map[string]interface{}
+	data, _ := io.ReadAll(req.Body)
+	_ = json.Unmarshal(data, &body)
+	s.requests = append(s.requests, body)
+
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(response)),
+		Request:    req,
+	}, nil
+}
+
+func newStubClient(t *testing.T, responses ...string) (*Client, *graphQLStub) {
+	stub := &graphQLStub{responses: responses}
+	gql, err := api.NewGraphQLClient(api.ClientOptions{
+		Host:      "github.com",
+		AuthToken: "token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	return &Client{gql: gql}, stub
+}
+
+func TestGetSubIssues(t *testing.T) {
+	client, stub := newStubClient(t,
+		`{"data":{"node":{"subIssues":{"nodes":[{"id":"I_1","number":11,"title":"First","state":"OPEN","url":"u1"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}}`,
+		`{"data":{"node":{"subIssues":{"nodes":[{"id":"I_2","number":12,"title":"Second","state":"CLOSED","url":"u2"}],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+	)
+
+	subIssues, err := client.GetSubIssues("I_parent")
+	require.NoError(t, err)
+
+	assert.Equal(t, []SubIssue{
+		{ID: "I_1", Number: 11, Title: "First", State: "OPEN", URL: "u1"},
+		{ID: "I_2", Number: 12, Title: "Second", State: "CLOSED", URL: "u2"},
+	}, subIssues)
+
+	require.Len(t, stub.requests, 2)
+	secondVars := stub.requests[1]["variables"].(map[string]interface{})
+	assert.Equal(t, "I_parent", secondVars["issueId"])
+	assert.Equal(t, "c1", secondVars["cursor"])
+}
+
+func TestGetSubIssuesError(t *testing.T) {
+	client, _ := newStubClient(t, `{"errors":[{"message":"Could not resolve to a node"}]}`)
+
+	_, err := client.GetSubIssues("I_missing")
+	assert.Error(t, err)
+}
+
+func TestAddSubIssueByURL(t *testing.T) {
+	client, stub := newStubClient(t,
+		`{"data":{"addSubIssue":{"subIssue":{"id":"I_3","number":13,"title":"Child","state":"OPEN","url":"https://github.com/owner/repo/issues/13"}}}}`,
+	)
+
+	subIssue, err := client.AddSubIssueByURL("I_parent", "https://github.com/owner/repo/issues/13")
+	require.NoError(t, err)
+	assert.Equal(t, 13, subIssue.Number)
+	assert.Equal(t, "I_3", subIssue.ID)
+
+	vars := stub.requests[0]["variables"].(map[string]interface{})
+	assert.Equal(t, "I_parent", vars["issueId"])
+	assert.Equal(t, "https://github.com/owner/repo/issues/13", vars["subIssueUrl"])
+}
+
+func TestReprioritizeSubIssue(t *testing.T) {
+	client, stub := newStubClient(t, `{"data":{"reprioritizeSubIssue":{"issue":{"id":"I_parent"}}}}`)
+
+	require.NoError(t, client.ReprioritizeSubIssue("I_parent", "I_2", "I_1", ""))
+
+	vars := stub.requests[0]["variables"].(map[string]interface{})
+	assert.Equal(t, "I_parent", vars["issueId"])
+	assert.Equal(t, "I_2", vars["subIssueId"])
+	assert.Equal(t, "I_1", vars["beforeId"])
+	assert.Nil(t, vars["afterId"])
+
+	assert.Error(t, client.ReprioritizeSubIssue("I_parent", "I_2", "", ""))
+	assert.Error(t, client.ReprioritizeSubIssue("I_parent", "I_2", "I_1", "I_3"))
+}
+
+func TestRemoveAndAddSubIssue(t *testing.T) {
+	client, stub := newStubClient(t,
+		`{"data":{"removeSubIssue":{"subIssue":{"id":"I_2"}}}}`,
+		`{"data":{"addSubIssue":{"subIssue":{"id":"I_2"}}}}`,
+	)
+
+	require.NoError(t, client.RemoveSubIssue("I_old", "I_2"))
+	require.NoError(t, client.AddSubIssue("I_new", "I_2"))
+
+	require.Len(t, stub.requests, 2)
+	assert.Contains(t, stub.requests[0]["query"], "removeSubIssue")
+	assert.Equal(t, "I_old", stub.requests[0]["variables"].(map[string]interface{})["issueId"])
+	assert.Contains(t, stub.requests[1]["query"], "addSubIssue")
+	assert.Equal(t, "I_new", stub.requests[1]["variables"].(map[string]interface{})["issueId"])
+}
+
+func TestGetParentIssue(t *testing.T) {
+	client, _ := newStubClient(t,
+		`{"data":{"node":{"parent":{"id":"I_1","number":1,"title":"Epic","state":"OPEN","url":"u1"}}}}`,
+		`{"data":{"node":{"parent":null}}}`,
+	)
+
+	parent, err := client.GetParentIssue("I_2")
+	require.NoError(t, err)
+	require.NotNil(t, parent)
+	assert.Equal(t, 1, parent.Number)
+
+	parent, err = client.GetParentIssue("I_1")
+	require.NoError(t, err)
+	assert.Nil(t, parent)
+}
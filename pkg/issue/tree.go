@@ -0,0 +1,251 @@
+package issue
+
+import (
+	"strings"
+)
+
+// IssueTree is an issue with its sub-issues, recursively
+type IssueTree struct {
+	ID        string       `json:"id"`
+	Number    int          `json:"number"`
+	Title     string       `json:"title"`
+	State     string       `json:"state"`
+	URL       string       `json:"url"`
+	Assignees []string     `json:"assignees"`
+	Status    string       `json:"status,omitempty"`
+	Value     *float64     `json:"value,omitempty"`     // The TreeOptions.NumberField value, if requested and set
+	Completed int          `json:"completed"`           // Closed issues below this one
+	Total     int          `json:"total"`               // All issues below this one
+	Percent   int          `json:"percent"`             // Completion of this issue and its descendants; 0 when Truncated
+	Truncated bool         `json:"truncated,omitempty"` // Has sub-issues that were not fetched because of TreeOptions.MaxDepth
+	Partial   bool         `json:"partial,omitempty"`   // A descendant is Truncated, so Completed, Total and Percent cover only the fetched issues
+	Children  []*IssueTree `json:"children,omitempty"`
+
+	subIssueCount int
+}
+
+// TreeOptions controls what GetIssueTree fetches
+type TreeOptions struct {
+	StatusField string // Single select field shown as the status
+	NumberField string // Optional number field to read into Value, e.g. "Estimate"
+	ProjectID   string // Project to read fields from; any project when empty
+	MaxDepth    int    // Levels of sub-issues to fetch; 0 for all
+}
+
+// treeNodeFields is the GraphQL selection for tree nodes; it needs the
+// $statusField and $numberField variables
+const treeNodeFields = `
+	id
+	number
+	title
+	state
+	url
+	assignees(first: 10) {
+		nodes { login }
+	}
+	projectItems(first: 20) {
+		nodes {
+			project { id }
+			fieldValueByName(name: $statusField) {
+				... on ProjectV2ItemFieldSingleSelectValue { name }
+			}
+			numberValue: fieldValueByName(name: $numberField) {
+				... on ProjectV2ItemFieldNumberValue { number }
+			}
+		}
+	}
+	subIssuesSummary { total }`
+
+// treeNode is the GraphQL shape of a tree node
+type treeNode struct {
+	ID        string `json:"id"`
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	URL       string `json:"url"`
+	Assignees struct {
+		Nodes []struct {
+			Login string `json:"login"`
+		} `json:"nodes"`
+	} `json:"assignees"`
+	ProjectItems struct {
+		Nodes []struct {
+			Project struct {
+				ID string `json:"id"`
+			} `json:"project"`
+			FieldValueByName *struct {
+				Name string `json:"name"`
+			} `json:"fieldValueByName"`
+			NumberValue *struct {
+				Number *float64 `json:"number"`
+			} `json:"numberValue"`
+		} `json:"nodes"`
+	} `json:"projectItems"`
+	SubIssuesSummary struct {
+		Total int `json:"total"`
+	} `json:"subIssuesSummary"`
+}
+
+// toTree converts a node, taking the field values from the given project's
+// item (or the first item that has them when projectID is empty)
+func (n treeNode) toTree(projectID string) *IssueTree {
+	tree := &IssueTree{
+		ID:            n.ID,
+		Number:        n.Number,
+		Title:         n.Title,
+		State:         n.State,
+		URL:           n.URL,
+		Assignees:     []string{},
+		subIssueCount: n.SubIssuesSummary.Total,
+	}
+	for _, assignee := range n.Assignees.Nodes {
+		tree.Assignees = append(tree.Assignees, assignee.Login)
+	}
+	for _, item := range n.ProjectItems.Nodes {
+		if projectID != "" && item.Project.ID != projectID {
+			continue
+		}
+		if tree.Status == "" && item.FieldValueByName != nil {
+			tree.Status = item.FieldValueByName.Name
+		}
+		if tree.Value == nil && item.NumberValue != nil {
+			tree.Value = item.NumberValue.Number
+		}
+	}
+	return tree
+}
+
+// GetIssueTree fetches an issue and its sub-issues down to opts.MaxDepth levels
+func (c *Client) GetIssueTree(issueID string, opts TreeOptions) (*IssueTree, error) {
+	query := `
+		query($issueId: ID!, $statusField: String!, $numberField: String!) {
+			node(id: $issueId) {
+				... on Issue {` + treeNodeFields + `
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"issueId":     issueID,
+		"statusField": opts.StatusField,
+		"numberField": opts.NumberField,
+	}
+
+	var result struct {
+		Node treeNode `json:"node"`
+	}
+	if err := c.gql.Do(query, variables, &result); err != nil {
+		return nil, NewAPIError("failed to get issue", err)
+	}
+
+	root := result.Node.toTree(opts.ProjectID)
+	if err := c.fetchTreeChildren(root, opts, 1); err != nil {
+		return nil, err
+	}
+	root.computeProgress()
+	return root, nil
+}
+
+// fetchTreeChildren loads the sub-issues of node and, recursively, theirs
+func (c *Client) fetchTreeChildren(node *IssueTree, opts TreeOptions, level int) error {
+	if node.subIssueCount == 0 {
+		return nil
+	}
+	if opts.MaxDepth > 0 && level > opts.MaxDepth {
+		node.Truncated = true
+		return nil
+	}
+
+	query := `
+		query($issueId: ID!, $statusField: String!, $numberField: String!, $cursor: String) {
+			node(id: $issueId) {
+				... on Issue {
+					subIssues(first: 50, after: $cursor) {
+						nodes {` + treeNodeFields + `
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		}`
+
+	var cursor *string
+	for {
+		variables := map[string]interface{}{
+			"issueId":     node.ID,
+			"statusField": opts.StatusField,
+			"numberField": opts.NumberField,
+			"cursor":      cursor,
+		}
+
+		var result struct {
+			Node struct {
+				SubIssues struct {
+					Nodes    []treeNode `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"subIssues"`
+			} `json:"node"`
+		}
+
+		if err := c.gql.Do(query, variables, &result); err != nil {
+			return NewAPIError("failed to get sub-issues", err)
+		}
+
+		for _, child := range result.Node.SubIssues.Nodes {
+			node.Children = append(node.Children, child.toTree(opts.ProjectID))
+		}
+
+		if !result.Node.SubIssues.PageInfo.HasNextPage {
+			break
+		}
+		endCursor := result.Node.SubIssues.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+
+	for _, child := range node.Children {
+		if err := c.fetchTreeChildren(child, opts, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeProgress fills in Completed, Total, Percent and Partial for the whole
+// tree. An issue without sub-issues is 0% or 100% done depending on its state;
+// the progress of a truncated issue is unknown and left at 0.
+func (t *IssueTree) computeProgress() {
+	t.Completed, t.Total, t.Partial = 0, 0, false
+	for _, child := range t.Children {
+		child.computeProgress()
+		t.Total += 1 + child.Total
+		t.Completed += child.Completed
+		if child.IsClosed() {
+			t.Completed++
+		}
+		if child.Truncated || child.Partial {
+			t.Partial = true
+		}
+	}
+
+	switch {
+	case t.Truncated:
+		t.Percent = 0
+	case t.Total > 0:
+		t.Percent = t.Completed * 100 / t.Total
+	case t.IsClosed():
+		t.Percent = 100
+	default:
+		t.Percent = 0
+	}
+}
+
+// IsClosed reports whether the issue is closed
+func (t *IssueTree) IsClosed() bool {
+	return strings.EqualFold(t.State, "CLOSED")
+}
@@ -0,0 +1,74 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueTree(t *testing.T) {
+	client, stub := newStubClient(t,
+		// Root
+		`{"data":{"node":{"id":"I_1","number":1,"title":"Epic","state":"OPEN","url":"u1",
+			"assignees":{"nodes":[{"login":"alice"}]},
+			"projectItems":{"nodes":[
+				{"project":{"id":"P_other"},"fieldValueByName":{"name":"Backlog"}},
+				{"project":{"id":"P_1"},"fieldValueByName":{"name":"In Progress"}}]},
+			"subIssuesSummary":{"total":2}}}}`,
+		// Children of the root
+		`{"data":{"node":{"subIssues":{"nodes":[
+			{"id":"I_2","number":2,"title":"Backend","state":"OPEN","url":"u2","assignees":{"nodes":[]},"projectItems":{"nodes":[]},"subIssuesSummary":{"total":2}},
+			{"id":"I_3","number":3,"title":"Docs","state":"CLOSED","url":"u3","assignees":{"nodes":[]},"projectItems":{"nodes":[{"project":{"id":"P_1"},"fieldValueByName":null}]},"subIssuesSummary":{"total":0}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+		// Children of Backend; Docs has none and is not queried
+		`{"data":{"node":{"subIssues":{"nodes":[
+			{"id":"I_4","number":4,"title":"API","state":"CLOSED","url":"u4","assignees":{"nodes":[]},"projectItems":{"nodes":[{"project":{"id":"P_1"},"fieldValueByName":null,"numberValue":{"number":3}}]},"subIssuesSummary":{"total":0}},
+			{"id":"I_5","number":5,"title":"Migration","state":"OPEN","url":"u5","assignees":{"nodes":[]},"projectItems":{"nodes":[]},"subIssuesSummary":{"total":0}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+	)
+
+	tree, err := client.GetIssueTree("I_1", TreeOptions{StatusField: "Status", NumberField: "Estimate", ProjectID: "P_1"})
+	require.NoError(t, err)
+	require.Len(t, stub.requests, 3)
+
+	assert.Equal(t, "In Progress", tree.Status, "status comes from the configured project")
+	assert.Equal(t, []string{"alice"}, tree.Assignees)
+	assert.Equal(t, 2, tree.Completed)
+	assert.Equal(t, 4, tree.Total)
+	assert.Equal(t, 50, tree.Percent)
+
+	require.Len(t, tree.Children, 2)
+	backend, docs := tree.Children[0], tree.Children[1]
+	assert.Equal(t, 50, backend.Percent)
+	assert.Equal(t, 100, docs.Percent)
+	assert.Empty(t, docs.Status)
+	require.Len(t, backend.Children, 2)
+	require.NotNil(t, backend.Children[0].Value)
+	assert.Equal(t, 3.0, *backend.Children[0].Value)
+	assert.Nil(t, backend.Children[1].Value)
+
+	vars := stub.requests[0]["variables"].(map[string]interface{})
+	assert.Equal(t, "Status", vars["statusField"])
+	assert.Equal(t, "Estimate", vars["numberField"])
+}
+
+func TestGetIssueTreeMaxDepth(t *testing.T) {
+	client, stub := newStubClient(t,
+		`{"data":{"node":{"id":"I_1","number":1,"title":"Epic","state":"OPEN","url":"u1","assignees":{"nodes":[]},"projectItems":{"nodes":[]},"subIssuesSummary":{"total":1}}}}`,
+		`{"data":{"node":{"subIssues":{"nodes":[
+			{"id":"I_2","number":2,"title":"Child","state":"OPEN","url":"u2","assignees":{"nodes":[]},"projectItems":{"nodes":[]},"subIssuesSummary":{"total":3}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`,
+	)
+
+	tree, err := client.GetIssueTree("I_1", TreeOptions{StatusField: "Status", MaxDepth: 1})
+	require.NoError(t, err)
+	assert.Len(t, stub.requests, 2, "grandchildren are not fetched beyond the depth")
+	require.Len(t, tree.Children, 1)
+	assert.Empty(t, tree.Children[0].Children)
+	assert.True(t, tree.Children[0].Truncated, "its sub-issues were not fetched")
+	assert.False(t, tree.Truncated)
+	assert.True(t, tree.Partial, "the child's hidden sub-issues are not in the total")
+	assert.Equal(t, 1, tree.Total)
+	assert.Equal(t, 0, tree.Percent)
+}
@@ -180,6 +180,9 @@ func (f *Formatter) formatBatchResultTable(result *issue.BatchResult) error {
 	fmt.Fprintf(w, "Total:\t%d\n", result.Total)
 	fmt.Fprintf(w, "Succeeded:\t%d\n", result.Succeeded)
 	fmt.Fprintf(w, "Failed:\t%d\n", result.Failed)
+	if result.Skipped > 0 {
+		fmt.Fprintf(w, "Skipped:\t%d\n", result.Skipped)
+	}
 
 	if len(result.Issues) > 0 {
 		fmt.Fprintf(w, "\nCreated Issues:\n")
@@ -196,9 +199,25 @@ func (f *Formatter) formatBatchResultTable(result *issue.BatchResult) error {
 		}
 	}
 
+	if len(result.Conflicts) > 0 {
+		fmt.Fprintf(w, "\nConflicts:\n")
+		fmt.Fprintf(w, "Number\tField\tExpected\tActual\tResolution\n")
+		for _, c := range result.Conflicts {
+			fmt.Fprintf(w, "#%d\t%s\t%s\t%s\t%s\n", c.Number, c.Field, displayValue(c.Expected), displayValue(c.Actual), c.Resolution)
+		}
+	}
+
 	return nil
 }
 
+// displayValue renders an empty field value as a dash
+func displayValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 // formatBatchResultJSON formats batch results as JSON
 func (f *Formatter) formatBatchResultJSON(result *issue.BatchResult) error {
 	encoder := json.NewEncoder(f.writer)
@@ -224,6 +243,9 @@ func (f *Formatter) formatBatchResultCSV(result *issue.BatchResult) error {
 	if err := w.Write([]string{"Failed", fmt.Sprintf("%d", result.Failed)}); err != nil {
 		return err
 	}
+	if err := w.Write([]string{"Skipped", fmt.Sprintf("%d", result.Skipped)}); err != nil {
+		return err
+	}
 
 	// Empty line
 	if err := w.Write([]string{}); err != nil {
@@ -247,6 +269,28 @@ func (f *Formatter) formatBatchResultCSV(result *issue.BatchResult) error {
 		}
 	}
 
+	// Write conflicts if any
+	if len(result.Conflicts) > 0 {
+		if err := w.Write([]string{}); err != nil {
+			return err
+		}
+		if err := w.Write([]string{"Number", "Field", "Expected", "Actual", "Resolution"}); err != nil {
+			return err
+		}
+		for _, c := range result.Conflicts {
+			record := []string{
+				fmt.Sprintf("%d", c.Number),
+				c.Field,
+				c.Expected,
+				c.Actual,
+				c.Resolution,
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -337,6 +381,35 @@ func (f *Formatter) formatIssueViewTable(issue *issue.Issue) error {
 		}
 	}
 
+	// Relationships
+	if issue.Parent != nil {
+		fmt.Fprintf(w, "\nParent:\t#%d %s (%s)\n", issue.Parent.Number, issue.Parent.Title, strings.ToLower(issue.Parent.State))
+	}
+
+	if len(issue.SubIssues) > 0 {
+		closed := 0
+		for _, subIssue := range issue.SubIssues {
+			if strings.EqualFold(subIssue.State, "CLOSED") {
+				closed++
+			}
+		}
+		fmt.Fprintf(w, "\nSub-Issues (%d/%d closed):\n", closed, len(issue.SubIssues))
+		for _, subIssue := range issue.SubIssues {
+			details := []string{strings.ToLower(subIssue.State)}
+			if subIssue.Status != "" {
+				details = append(details, subIssue.Status)
+			}
+			fmt.Fprintf(w, "  #%d %s\t[%s]\n", subIssue.Number, subIssue.Title, strings.Join(details, ", "))
+		}
+	}
+
+	if len(issue.PullRequests) > 0 {
+		fmt.Fprintf(w, "\nPull Requests:\n")
+		for _, pr := range issue.PullRequests {
+			fmt.Fprintf(w, "  %s#%d %s\t[%s]\n", pr.Repository, pr.Number, pr.Title, pullRequestDetails(pr))
+		}
+	}
+
 	// Comments
 	if len(issue.Comments) > 0 {
 		fmt.Fprintf(w, "\nComments (%d):\n", len(issue.Comments))
@@ -349,9 +422,69 @@ func (f *Formatter) formatIssueViewTable(issue *issue.Issue) error {
 		}
 	}
 
+	// History
+	if len(issue.History) > 0 {
+		fmt.Fprintf(w, "\nHistory:\n")
+		for _, event := range issue.History {
+			actor := "-"
+			if event.Actor != "" {
+				actor = "@" + event.Actor
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", event.CreatedAt.Local().Format("2006-01-02 15:04"), actor, historyDescription(event))
+		}
+	}
+
 	// Timestamps
 	fmt.Fprintf(w, "\nCreated:\t%s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
 	fmt.Fprintf(w, "Updated:\t%s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
 
 	return nil
 }
+
+// historyDescription describes what a history event changed
+func historyDescription(event issue.HistoryEvent) string {
+	project := ""
+	if event.Project != "" {
+		project = fmt.Sprintf(" (%s)", event.Project)
+	}
+
+	switch event.Type {
+	case issue.HistoryAddedToProject:
+		return "Added to project" + project
+	case issue.HistoryRemovedFromProject:
+		return "Removed from project" + project
+	case issue.HistoryStatusChanged:
+		from := event.From
+		if from == "" {
+			from = "(none)"
+		}
+		return fmt.Sprintf("Status: %s → %s%s", from, event.To, project)
+	case issue.HistoryLabeled:
+		return "Added label " + event.To
+	case issue.HistoryUnlabeled:
+		return "Removed label " + event.From
+	case issue.HistoryAssigned:
+		return "Assigned @" + event.To
+	case issue.HistoryUnassigned:
+		return "Unassigned @" + event.From
+	case issue.HistoryRolledOver:
+		return fmt.Sprintf("Iteration: %s → %s (rolled over)", event.From, event.To)
+	default:
+		return event.Type
+	}
+}
+
+// pullRequestDetails summarizes the merge and review state of a linked pull request
+func pullRequestDetails(pr issue.LinkedPullRequest) string {
+	details := []string{strings.ToLower(pr.State)}
+	if pr.IsDraft {
+		details = append(details, "draft")
+	}
+	if pr.ReviewDecision != "" && !pr.Merged {
+		details = append(details, strings.ToLower(strings.ReplaceAll(pr.ReviewDecision, "_", " ")))
+	}
+	if pr.Closes {
+		details = append(details, "closes this issue")
+	}
+	return strings.Join(details, ", ")
+}
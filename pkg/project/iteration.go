@@ -0,0 +1,195 @@
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Iteration is one iteration (sprint) of an iteration field
+type Iteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"startDate"` // YYYY-MM-DD
+	Duration  int    `json:"duration"`  // Days
+	Completed bool   `json:"completed"`
+}
+
+// Start returns the first day of the iteration, at local midnight
+func (it Iteration) Start() time.Time {
+	start, _ := time.ParseInLocation("2006-01-02", it.StartDate, time.Local)
+	return start
+}
+
+// End returns the day after the last day of the iteration, at local midnight
+func (it Iteration) End() time.Time {
+	return it.Start().AddDate(0, 0, it.Duration)
+}
+
+// Contains reports whether the time falls within the iteration
+func (it Iteration) Contains(t time.Time) bool {
+	return !t.Before(it.Start()) && t.Before(it.End())
+}
+
+// IterationField is an iteration field with its configuration
+type IterationField struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Duration   int         `json:"duration"` // Default iteration length in days
+	StartDay   int         `json:"startDay"` // Day of the week iterations start on, 1 is Monday
+	Iterations []Iteration `json:"iterations"`
+}
+
+// GetIterationFields fetches the project's iteration fields with their
+// active and completed iterations, oldest first
+func (c *Client) GetIterationFields(projectID string) ([]IterationField, error) {
+	query := `
+		query($projectId: ID!) {
+			node(id: $projectId) {
+				... on ProjectV2 {
+					fields(first: 100) {
+						nodes {
+							... on ProjectV2IterationField {
+								id
+								name
+								configuration {
+									duration
+									startDay
+									iterations {
+										id
+										title
+										startDate
+										duration
+									}
+									completedIterations {
+										id
+										title
+										startDate
+										duration
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"projectId": projectID,
+	}
+
+	var result struct {
+		Node struct {
+			Fields struct {
+				Nodes []json.RawMessage `json:"nodes"`
+			} `json:"fields"`
+		} `json:"node"`
+	}
+
+	if err := c.graphQL(query, variables, &result); err != nil {
+		return nil, fmt.Errorf("failed to get iteration fields: %w", err)
+	}
+
+	var fields []IterationField
+	for _, node := range result.Node.Fields.Nodes {
+		var raw struct {
+			ID            string `json:"id"`
+			Name          string `json:"name"`
+			Configuration *struct {
+				Duration            int         `json:"duration"`
+				StartDay            int         `json:"startDay"`
+				Iterations          []Iteration `json:"iterations"`
+				CompletedIterations []Iteration `json:"completedIterations"`
+			} `json:"configuration"`
+		}
+		// Other field types decode without a configuration
+		if err := json.Unmarshal(node, &raw); err != nil || raw.ID == "" || raw.Configuration == nil {
+			continue
+		}
+
+		field := IterationField{
+			ID:       raw.ID,
+			Name:     raw.Name,
+			Duration: raw.Configuration.Duration,
+			StartDay: raw.Configuration.StartDay,
+		}
+		for _, iteration := range raw.Configuration.CompletedIterations {
+			iteration.Completed = true
+			field.Iterations = append(field.Iterations, iteration)
+		}
+		field.Iterations = append(field.Iterations, raw.Configuration.Iterations...)
+		sort.SliceStable(field.Iterations, func(i, j int) bool {
+			return field.Iterations[i].StartDate < field.Iterations[j].StartDate
+		})
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// Current returns the iteration that contains now, or nil
+func (f *IterationField) Current(now time.Time) *Iteration {
+	for i := range f.Iterations {
+		if f.Iterations[i].Contains(now) {
+			return &f.Iterations[i]
+		}
+	}
+	return nil
+}
+
+// Next returns the first iteration that starts after the given one, or nil
+func (f *IterationField) Next(after Iteration) *Iteration {
+	for i := range f.Iterations {
+		if f.Iterations[i].Start().After(after.Start()) {
+			return &f.Iterations[i]
+		}
+	}
+	return nil
+}
+
+// Previous returns the last iteration that starts before the given one, or nil
+func (f *IterationField) Previous(before Iteration) *Iteration {
+	for i := len(f.Iterations) - 1; i >= 0; i-- {
+		if f.Iterations[i].Start().Before(before.Start()) {
+			return &f.Iterations[i]
+		}
+	}
+	return nil
+}
+
+// Resolve finds an iteration by title (case-insensitive) or by one of
+// @current, @next and @previous, relative to now
+func (f *IterationField) Resolve(name string, now time.Time) (*Iteration, error) {
+	switch strings.ToLower(name) {
+	case "", "@current":
+		if current := f.Current(now); current != nil {
+			return current, nil
+		}
+		return nil, fmt.Errorf("no iteration of '%s' is in progress", f.Name)
+	case "@next", "@previous":
+		current := f.Current(now)
+		if current == nil {
+			return nil, fmt.Errorf("no iteration of '%s' is in progress", f.Name)
+		}
+		var target *Iteration
+		if strings.EqualFold(name, "@next") {
+			target = f.Next(*current)
+		} else {
+			target = f.Previous(*current)
+		}
+		if target == nil {
+			return nil, fmt.Errorf("'%s' has no %s iteration", f.Name, strings.TrimPrefix(strings.ToLower(name), "@"))
+		}
+		return target, nil
+	}
+
+	for i := range f.Iterations {
+		if strings.EqualFold(f.Iterations[i].Title, name) {
+			return &f.Iterations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("iteration '%s' not found in '%s'", name, f.Name)
+}
@@ -0,0 +1,78 @@
+package project
+
+import (
+	"testing"
+	"time"
+)
+
+func sampleIterationField() *IterationField {
+	return &IterationField{
+		Name: "Sprint",
+		Iterations: []Iteration{
+			{ID: "it1", Title: "Sprint 1", StartDate: "2026-03-02", Duration: 14, Completed: true},
+			{ID: "it2", Title: "Sprint 2", StartDate: "2026-03-16", Duration: 14},
+			{ID: "it3", Title: "Sprint 3", StartDate: "2026-03-30", Duration: 14},
+		},
+	}
+}
+
+func TestIterationFieldResolve(t *testing.T) {
+	field := sampleIterationField()
+	now := time.Date(2026, 3, 29, 23, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "", want: "it2"},
+		{name: "@current", want: "it2"},
+		{name: "@next", want: "it3"},
+		{name: "@previous", want: "it1"},
+		{name: "sprint 3", want: "it3"},
+		{name: "Sprint 9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := field.Resolve(tt.name, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Resolve(%q) expected an error, got %s", tt.name, got.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%q) unexpected error: %v", tt.name, err)
+			}
+			if got.ID != tt.want {
+				t.Errorf("Resolve(%q) = %s, want %s", tt.name, got.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestIterationFieldResolveOutsideIterations(t *testing.T) {
+	field := sampleIterationField()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.Local)
+
+	if _, err := field.Resolve("@current", now); err == nil {
+		t.Error("expected an error when no iteration is in progress")
+	}
+	if _, err := field.Resolve("@next", now); err == nil {
+		t.Error("expected an error for @next when no iteration is in progress")
+	}
+}
+
+func TestIterationEnd(t *testing.T) {
+	iteration := Iteration{StartDate: "2026-03-16", Duration: 14}
+	if got := iteration.End().Format("2006-01-02"); got != "2026-03-30" {
+		t.Errorf("End() = %s, want 2026-03-30", got)
+	}
+	if !iteration.Contains(time.Date(2026, 3, 29, 18, 0, 0, 0, time.Local)) {
+		t.Error("the last day should be part of the iteration")
+	}
+	if iteration.Contains(time.Date(2026, 3, 30, 0, 0, 0, 0, time.Local)) {
+		t.Error("the day after the last day should not be part of the iteration")
+	}
+}
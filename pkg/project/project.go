@@ -204,6 +204,11 @@ func (c *Client) GetProjectFields(projectID string) ([]Field, error) {
 									name
 								}
 							}
+							... on ProjectV2IterationField {
+								id
+								name
+								dataType
+							}
 						}
 					}
 				}
@@ -230,7 +235,7 @@ func (c *Client) GetProjectFields(projectID string) ([]Field, error) {
 	var fields []Field
 	for _, node := range result.Node.Fields.Nodes {
 		var field Field
-		if err := json.Unmarshal(node, &field); err != nil {
+		if err := json.Unmarshal(node, &field); err != nil || field.ID == "" {
 			continue
 		}
 		fields = append(fields, field)
@@ -345,7 +350,7 @@ func (c *Client) GetProjectItemForIssue(projectID, issueID string) (*ProjectItem
 								nodes {
 									... on ProjectV2ItemFieldSingleSelectValue {
 										field {
-											... on ProjectV2Field {
+											... on ProjectV2FieldCommon {
 												id
 											}
 										}
@@ -353,7 +358,7 @@ func (c *Client) GetProjectItemForIssue(projectID, issueID string) (*ProjectItem
 									}
 									... on ProjectV2ItemFieldTextValue {
 										field {
-											... on ProjectV2Field {
+											... on ProjectV2FieldCommon {
 												id
 											}
 										}
@@ -361,7 +366,7 @@ func (c *Client) GetProjectItemForIssue(projectID, issueID string) (*ProjectItem
 									}
 									... on ProjectV2ItemFieldNumberValue {
 										field {
-											... on ProjectV2Field {
+											... on ProjectV2FieldCommon {
 												id
 											}
 										}
@@ -369,12 +374,20 @@ func (c *Client) GetProjectItemForIssue(projectID, issueID string) (*ProjectItem
 									}
 									... on ProjectV2ItemFieldDateValue {
 										field {
-											... on ProjectV2Field {
+											... on ProjectV2FieldCommon {
 												id
 											}
 										}
 										date
 									}
+									... on ProjectV2ItemFieldIterationValue {
+										field {
+											... on ProjectV2FieldCommon {
+												id
+											}
+										}
+										iterationId
+									}
 								}
 							}
 						}
@@ -420,6 +433,8 @@ func (c *Client) GetProjectItemForIssue(projectID, issueID string) (*ProjectItem
 						// Get the value based on the field type
 						if optionID, ok := fv["optionId"].(string); ok {
 							fieldValues[fieldID] = optionID
+						} else if iterationID, ok := fv["iterationId"].(string); ok {
+							fieldValues[fieldID] = iterationID
 						} else if text, ok := fv["text"].(string); ok {
 							fieldValues[fieldID] = text
 						} else if number, ok := fv["number"].(float64); ok {
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// ANSI styles used by the renderers
+const (
+	Reset = "\x1b[0m"
+	Bold  = "\x1b[1m"
+	Dim   = "\x1b[2m"
+	Cyan  = "\x1b[36m"
+	Green = "\x1b[32m"
+)
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// StripANSI removes ANSI escape sequences from a string
+func StripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
+
+// VisibleWidth returns the number of terminal cells a string occupies,
+// ignoring ANSI escape sequences and accounting for wide characters
+func VisibleWidth(s string) int {
+	return uniseg.StringWidth(StripANSI(s))
+}
+
+// Truncate cuts a plain string so it fits in width cells
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if uniseg.StringWidth(s) <= width {
+		return s
+	}
+
+	var b strings.Builder
+	used := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		w := g.Width()
+		if used+w > width-1 {
+			break
+		}
+		b.WriteString(g.Str())
+		used += w
+	}
+	b.WriteString("…")
+	return b.String()
+}
+
+// Wrap breaks plain text into lines of at most width cells, splitting on spaces
+// where possible and hard-breaking words that are longer than a line
+func Wrap(text string, width int) []string {
+	if width <= 0 {
+		return []string{text}
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		current := ""
+		for _, word := range words {
+			for uniseg.StringWidth(word) > width {
+				if current != "" {
+					lines = append(lines, current)
+					current = ""
+				}
+				head, tail := splitAtWidth(word, width)
+				lines = append(lines, head)
+				word = tail
+			}
+			if current == "" {
+				current = word
+			} else if uniseg.StringWidth(current)+1+uniseg.StringWidth(word) <= width {
+				current += " " + word
+			} else {
+				lines = append(lines, current)
+				current = word
+			}
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+	}
+	return lines
+}
+
+// splitAtWidth splits a string at the last grapheme that fits in width cells
+func splitAtWidth(s string, width int) (string, string) {
+	used := 0
+	pos := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		w := g.Width()
+		if used+w > width {
+			break
+		}
+		used += w
+		_, pos = g.Positions()
+	}
+	if pos == 0 {
+		// Always make progress, even if a single grapheme is wider than the line
+		g = uniseg.NewGraphemes(s)
+		g.Next()
+		_, pos = g.Positions()
+	}
+	return s[:pos], s[pos:]
+}
+
+// Pad right-pads a (possibly styled) string with spaces to width cells
+func Pad(s string, width int) string {
+	w := VisibleWidth(s)
+	if w >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-w)
+}
+
+// Columns places two blocks of lines side by side separated by a vertical rule,
+// producing exactly height lines
+func Columns(left, right []string, leftWidth, height int) []string {
+	lines := make([]string, 0, height)
+	for i := 0; i < height; i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		lines = append(lines, Pad(l, leftWidth)+Reset+" "+Dim+"│"+Reset+" "+r+Reset)
+	}
+	return lines
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	checkboxPattern = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s*(.*)$`)
+	bulletPattern   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	orderedPattern  = regexp.MustCompile(`^(\s*)(\d+[.)])\s+(.*)$`)
+	rulePattern     = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	linkPattern     = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	emphasisPattern = regexp.MustCompile("(\\*\\*|__|`)")
+)
+
+// RenderMarkdown renders GitHub-flavored markdown into styled terminal lines
+// no wider than width cells. Only the block-level constructs that commonly
+// appear in issue bodies are styled; inline markup is simplified to plain text.
+func RenderMarkdown(src string, width int) []string {
+	var lines []string
+	inCode := false
+
+	for _, raw := range strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(raw)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			lines = append(lines, Dim+"  "+Truncate(strings.ReplaceAll(raw, "\t", "    "), width-2)+Reset)
+			continue
+		}
+
+		if trimmed == "" {
+			// Collapse consecutive blank lines
+			if len(lines) > 0 && lines[len(lines)-1] != "" {
+				lines = append(lines, "")
+			}
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(trimmed); m != nil {
+			for _, l := range Wrap(inline(m[2]), width) {
+				lines = append(lines, Bold+Cyan+l+Reset)
+			}
+			continue
+		}
+
+		if rulePattern.MatchString(trimmed) {
+			lines = append(lines, Dim+strings.Repeat("─", width)+Reset)
+			continue
+		}
+
+		if m := checkboxPattern.FindStringSubmatch(raw); m != nil {
+			box := "☐ "
+			if m[2] != " " {
+				box = "☑ "
+			}
+			lines = append(lines, hanging(indentOf(m[1])+box, inline(m[3]), width)...)
+			continue
+		}
+
+		if m := orderedPattern.FindStringSubmatch(raw); m != nil {
+			lines = append(lines, hanging(indentOf(m[1])+m[2]+" ", inline(m[3]), width)...)
+			continue
+		}
+
+		if m := bulletPattern.FindStringSubmatch(raw); m != nil {
+			lines = append(lines, hanging(indentOf(m[1])+"• ", inline(m[2]), width)...)
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, ">") {
+			text := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			for _, l := range Wrap(inline(text), width-2) {
+				lines = append(lines, Dim+"│ "+l+Reset)
+			}
+			continue
+		}
+
+		lines = append(lines, Wrap(inline(trimmed), width)...)
+	}
+
+	// Drop trailing blank line
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// inline simplifies inline markup: links keep their text, emphasis markers are removed
+func inline(s string) string {
+	s = linkPattern.ReplaceAllString(s, "$1")
+	return emphasisPattern.ReplaceAllString(s, "")
+}
+
+// indentOf converts leading whitespace of a list item into a nesting indent
+func indentOf(ws string) string {
+	level := len(strings.ReplaceAll(ws, "\t", "  ")) / 2
+	return strings.Repeat("  ", level)
+}
+
+// hanging wraps text after a prefix, aligning continuation lines under the text
+func hanging(prefix, text string, width int) []string {
+	prefixWidth := VisibleWidth(prefix)
+	wrapped := Wrap(text, width-prefixWidth)
+	lines := make([]string, len(wrapped))
+	for i, l := range wrapped {
+		if i == 0 {
+			lines[i] = prefix + l
+		} else {
+			lines[i] = strings.Repeat(" ", prefixWidth) + l
+		}
+	}
+	return lines
+}
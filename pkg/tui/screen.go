@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// KeyCode identifies the kind of key that was pressed
+type KeyCode int
+
+const (
+	// KeyRune is a printable character, stored in Key.Rune
+	KeyRune KeyCode = iota
+	// KeyEnter is the Enter/Return key
+	KeyEnter
+	// KeyBackspace is the Backspace/Delete key
+	KeyBackspace
+	// KeyEscape is a lone Escape key
+	KeyEscape
+	// KeyTab is the Tab key
+	KeyTab
+	// KeyUp is the up arrow
+	KeyUp
+	// KeyDown is the down arrow
+	KeyDown
+	// KeyLeft is the left arrow
+	KeyLeft
+	// KeyRight is the right arrow
+	KeyRight
+	// KeyCtrlC is Ctrl+C
+	KeyCtrlC
+	// KeyCtrlO is Ctrl+O
+	KeyCtrlO
+	// KeyUnknown is any sequence that could not be decoded
+	KeyUnknown
+)
+
+// Key represents a single key press
+type Key struct {
+	Code KeyCode
+	Rune rune
+}
+
+// KeyReader reads key presses one at a time
+type KeyReader interface {
+	ReadKey() (Key, error)
+}
+
+// Screen is a full-screen terminal session in raw mode
+type Screen struct {
+	in    *os.File
+	out   io.Writer
+	fd    int
+	state *term.State
+}
+
+// IsTerminal reports whether the file is attached to a terminal
+func IsTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// Open switches the terminal to raw mode and the alternate screen buffer
+func Open() (*Screen, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("stdin is not a terminal")
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable raw mode: %w", err)
+	}
+
+	s := &Screen{
+		in:    os.Stdin,
+		out:   os.Stdout,
+		fd:    fd,
+		state: state,
+	}
+
+	// Enter alternate screen and hide cursor
+	fmt.Fprint(s.out, "\x1b[?1049h\x1b[?25l")
+	return s, nil
+}
+
+// Close restores the terminal to its original state
+func (s *Screen) Close() error {
+	// Show cursor and leave alternate screen
+	fmt.Fprint(s.out, "\x1b[?25h\x1b[?1049l")
+	return term.Restore(s.fd, s.state)
+}
+
+// Size returns the terminal width and height, falling back to 100x30
+func (s *Screen) Size() (int, int) {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 100, 30
+	}
+	return width, height
+}
+
+// Draw clears the screen and writes the given lines
+func (s *Screen) Draw(lines []string) {
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	for i, line := range lines {
+		if i > 0 {
+			// Raw mode does not translate \n into \r\n
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+	}
+	fmt.Fprint(s.out, b.String())
+}
+
+// ReadKey blocks until a key is pressed and decodes it
+func (s *Screen) ReadKey() (Key, error) {
+	buf := make([]byte, 16)
+	n, err := s.in.Read(buf)
+	if err != nil {
+		return Key{}, err
+	}
+	return DecodeKey(buf[:n]), nil
+}
+
+// DecodeKey decodes a raw byte sequence read from the terminal
+func DecodeKey(b []byte) Key {
+	if len(b) == 0 {
+		return Key{Code: KeyUnknown}
+	}
+
+	switch b[0] {
+	case '\r', '\n':
+		return Key{Code: KeyEnter}
+	case 0x7f, 0x08:
+		return Key{Code: KeyBackspace}
+	case '\t':
+		return Key{Code: KeyTab}
+	case 0x03:
+		return Key{Code: KeyCtrlC}
+	case 0x0f:
+		return Key{Code: KeyCtrlO}
+	case 0x1b:
+		if len(b) == 1 {
+			return Key{Code: KeyEscape}
+		}
+		// CSI (ESC [) or SS3 (ESC O) arrow sequences
+		if len(b) >= 3 && (b[1] == '[' || b[1] == 'O') {
+			switch b[2] {
+			case 'A':
+				return Key{Code: KeyUp}
+			case 'B':
+				return Key{Code: KeyDown}
+			case 'C':
+				return Key{Code: KeyRight}
+			case 'D':
+				return Key{Code: KeyLeft}
+			}
+		}
+		return Key{Code: KeyUnknown}
+	}
+
+	r := []rune(string(b))
+	if len(r) == 0 || r[0] < 0x20 {
+		return Key{Code: KeyUnknown}
+	}
+	return Key{Code: KeyRune, Rune: r[0]}
+}
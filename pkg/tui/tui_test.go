@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected Key
+	}{
+		{name: "printable rune", input: []byte("a"), expected: Key{Code: KeyRune, Rune: 'a'}},
+		{name: "multibyte rune", input: []byte("あ"), expected: Key{Code: KeyRune, Rune: 'あ'}},
+		{name: "enter", input: []byte{'\r'}, expected: Key{Code: KeyEnter}},
+		{name: "backspace", input: []byte{0x7f}, expected: Key{Code: KeyBackspace}},
+		{name: "escape", input: []byte{0x1b}, expected: Key{Code: KeyEscape}},
+		{name: "left arrow", input: []byte("\x1b[D"), expected: Key{Code: KeyLeft}},
+		{name: "up arrow (SS3)", input: []byte("\x1bOA"), expected: Key{Code: KeyUp}},
+		{name: "ctrl+c", input: []byte{0x03}, expected: Key{Code: KeyCtrlC}},
+		{name: "ctrl+o", input: []byte{0x0f}, expected: Key{Code: KeyCtrlO}},
+		{name: "empty", input: []byte{}, expected: Key{Code: KeyUnknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DecodeKey(tt.input))
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected []string
+	}{
+		{name: "fits", text: "short line", width: 20, expected: []string{"short line"}},
+		{name: "wraps on spaces", text: "one two three four", width: 9, expected: []string{"one two", "three", "four"}},
+		{name: "hard breaks long words", text: "abcdefghij", width: 4, expected: []string{"abcd", "efgh", "ij"}},
+		{name: "wide characters", text: "日本語テキスト", width: 6, expected: []string{"日本語", "テキス", "ト"}},
+		{name: "keeps blank lines", text: "a\n\nb", width: 5, expected: []string{"a", "", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Wrap(tt.text, tt.width))
+		})
+	}
+}
+
+func TestTruncateAndWidth(t *testing.T) {
+	assert.Equal(t, "hello", Truncate("hello", 5))
+	assert.Equal(t, "hel…", Truncate("hello", 4))
+	assert.Equal(t, "", Truncate("hello", 0))
+	assert.Equal(t, 5, VisibleWidth(Bold+"hello"+Reset))
+	assert.Equal(t, 4, VisibleWidth("日本"))
+}
+
+func TestColumns(t *testing.T) {
+	lines := Columns([]string{"left"}, []string{"right", "more"}, 6, 3)
+
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(StripANSI(lines[0]), "left   │ right"))
+	assert.True(t, strings.HasPrefix(StripANSI(lines[1]), "       │ more"))
+	assert.Equal(t, "       │ ", StripANSI(lines[2]))
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	src := "## Summary\n\nSome **bold** text with a [link](https://example.com).\n\n\n- [ ] todo\n- [x] done\n  - nested\n1. first\n> quote\n```\ncode block\n```\n"
+
+	lines := RenderMarkdown(src, 40)
+	plain := make([]string, len(lines))
+	for i, l := range lines {
+		plain[i] = StripANSI(l)
+	}
+
+	assert.Equal(t, []string{
+		"Summary",
+		"",
+		"Some bold text with a link.",
+		"",
+		"☐ todo",
+		"☑ done",
+		"  • nested",
+		"1. first",
+		"│ quote",
+		"  code block",
+	}, plain)
+}
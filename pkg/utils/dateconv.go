@@ -139,3 +139,26 @@ func ConvertSearchQuery(query string) (string, error) {
 
 	return result, nil
 }
+
+// ParseAge parses an age such as 12h, 7d or 2w, using the same day and week
+// units as the @today expressions
+func ParseAge(input string) (time.Duration, error) {
+	matches := regexp.MustCompile(`^(\d+)([hdw])$`).FindStringSubmatch(strings.TrimSpace(input))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid age %q: expected a number followed by h, d or w", input)
+	}
+
+	num, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid number: %s", matches[1])
+	}
+
+	switch matches[2] {
+	case "h":
+		return time.Duration(num) * time.Hour, nil
+	case "w":
+		return time.Duration(num) * 7 * 24 * time.Hour, nil
+	default:
+		return time.Duration(num) * 24 * time.Hour, nil
+	}
+}
@@ -204,3 +204,37 @@ func TestConvertSearchQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{input: "7d", expected: 7 * 24 * time.Hour},
+		{input: "2w", expected: 14 * 24 * time.Hour},
+		{input: "12h", expected: 12 * time.Hour},
+		{input: " 3d ", expected: 3 * 24 * time.Hour},
+		{input: "7", wantErr: true},
+		{input: "1m", wantErr: true},
+		{input: "-2d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseAge(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseAge(%q) expected error, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAge(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseAge(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}